@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/nakul91/solana-trading-bot/journal"
+	"github.com/nakul91/solana-trading-bot/strategy"
+)
+
+// defaultBacktestJournalPath is where backtest runs journal their simulated
+// swaps, kept separate from the live trading journal so a backtest never
+// pollutes live PnL reporting.
+const defaultBacktestJournalPath = "backtest.db"
+
+// runBacktest implements `bot backtest <csv-path>`: it replays a CSV of
+// historical SOL/USDC prices through the configured strategy, journals the
+// simulated decisions and swaps, and prints a PnL summary built from the
+// same journal report `bot report` uses. The CSV has two columns, "timestamp,price", with an
+// optional header row; timestamp may be RFC3339 or a Unix second count.
+func runBacktest(config Config, csvPath string) error {
+	prices, err := readPriceCSV(csvPath)
+	if err != nil {
+		return fmt.Errorf("failed to read price CSV: %w", err)
+	}
+	if len(prices) == 0 {
+		return fmt.Errorf("price CSV %q has no rows", csvPath)
+	}
+
+	journalPath := defaultBacktestJournalPath
+	if err := os.Remove(journalPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to clear previous backtest journal: %w", err)
+	}
+	journalDB, err := journal.Open(journalPath)
+	if err != nil {
+		return fmt.Errorf("failed to open backtest journal: %w", err)
+	}
+	defer journalDB.Close()
+
+	historySize := config.PriceHistorySize
+	if historySize == 0 {
+		historySize = defaultPriceHistorySize
+	}
+	history := strategy.NewPriceHistory(historySize)
+	strat := newStrategy(config)
+
+	ctx := context.Background()
+	asset := "SOL"
+	balance := config.InitialBalanceUSD
+	var lastSwapPrice float64
+	var swapCount int
+	var lastSwapReset time.Time
+
+	for _, p := range prices {
+		history.Add(p.price, p.at)
+
+		if lastSwapPrice == 0 {
+			lastSwapPrice = p.price
+		}
+		if day := p.at.Truncate(24 * time.Hour); day.After(lastSwapReset) {
+			lastSwapReset = day
+			swapCount = 0
+		}
+
+		decision := strat.Decide(ctx, history, strategy.Position{Asset: asset, LastSwapPrice: lastSwapPrice})
+
+		correlationID := fmt.Sprintf("backtest-%d", p.at.Unix())
+		if err := journalDB.RecordDecision(ctx, journal.DecisionEntry{
+			CorrelationID: correlationID,
+			Time:          p.at,
+			Action:        decision.Kind.String(),
+			Reason:        decision.Reason,
+			Price:         p.price,
+		}); err != nil {
+			return fmt.Errorf("failed to journal decision: %w", err)
+		}
+
+		if decision.Kind == strategy.Hold || swapCount >= config.MaxSwapsPerDay {
+			continue
+		}
+
+		toAsset := map[string]string{"SOL": "USDC", "USDC": "SOL"}[asset]
+		traded := balance * decision.SizeFraction
+
+		swapID, err := journalDB.RecordSwap(ctx, journal.SwapEntry{
+			CorrelationID:   correlationID,
+			Time:            p.at,
+			FromAsset:       asset,
+			ToAsset:         toAsset,
+			Price:           p.price,
+			QuotedOutAmount: "",
+		})
+		if err != nil {
+			return fmt.Errorf("failed to journal simulated swap: %w", err)
+		}
+		if err := journalDB.ConfirmSwap(ctx, swapID, "", "", 0, 0, p.at); err != nil {
+			return fmt.Errorf("failed to confirm simulated swap: %w", err)
+		}
+
+		balance = traded
+		asset = toAsset
+		lastSwapPrice = p.price
+		swapCount++
+	}
+
+	report, err := journalDB.GenerateReport(ctx, prices[len(prices)-1].price, asset)
+	if err != nil {
+		return fmt.Errorf("failed to generate backtest report: %w", err)
+	}
+
+	fmt.Printf("Backtest: %d price points from %s\n", len(prices), csvPath)
+	fmt.Printf("Final position:         %.2f %s\n", balance, asset)
+	fmt.Printf("Confirmed swaps:        %d\n", report.ConfirmedSwaps)
+	fmt.Printf("Completed round trips:  %d\n", report.CompletedRoundTrips)
+	fmt.Printf("Win rate:               %.1f%%\n", report.WinRate*100)
+	fmt.Printf("Realized PnL:           %.2f%%\n", report.RealizedPnLPercent)
+	fmt.Printf("Unrealized PnL:         %.2f%%\n", report.UnrealizedPnLPercent)
+
+	return nil
+}
+
+type pricePoint struct {
+	at    time.Time
+	price float64
+}
+
+// readPriceCSV parses a "timestamp,price" CSV, skipping a non-numeric
+// header row if present.
+func readPriceCSV(path string) ([]pricePoint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = 2
+
+	var points []pricePoint
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		at, price, err := parsePriceRow(record[0], record[1])
+		if err != nil {
+			if len(points) == 0 {
+				// Likely a header row ("timestamp,price"); skip it.
+				continue
+			}
+			return nil, err
+		}
+		points = append(points, pricePoint{at: at, price: price})
+	}
+	return points, nil
+}
+
+func parsePriceRow(tsField, priceField string) (time.Time, float64, error) {
+	price, err := strconv.ParseFloat(priceField, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid price %q: %w", priceField, err)
+	}
+
+	if unixSeconds, err := strconv.ParseInt(tsField, 10, 64); err == nil {
+		return time.Unix(unixSeconds, 0), price, nil
+	}
+	at, err := time.Parse(time.RFC3339, tsField)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid timestamp %q: %w", tsField, err)
+	}
+	return at, price, nil
+}
@@ -0,0 +1,83 @@
+// Package journal persists every quote, swap decision, and submitted
+// transaction to a local SQLite database, so the bot's state survives a
+// restart and its trading history can be reported on.
+package journal
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS quotes (
+	id              INTEGER PRIMARY KEY AUTOINCREMENT,
+	correlation_id  TEXT NOT NULL,
+	ts              INTEGER NOT NULL,
+	input_mint      TEXT NOT NULL,
+	output_mint     TEXT NOT NULL,
+	in_amount       TEXT NOT NULL,
+	out_amount      TEXT NOT NULL,
+	slippage_bps    INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS decisions (
+	id              INTEGER PRIMARY KEY AUTOINCREMENT,
+	correlation_id  TEXT NOT NULL,
+	ts              INTEGER NOT NULL,
+	action          TEXT NOT NULL,
+	reason          TEXT NOT NULL,
+	price           REAL NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS swaps (
+	id                      INTEGER PRIMARY KEY AUTOINCREMENT,
+	correlation_id          TEXT NOT NULL,
+	ts                      INTEGER NOT NULL,
+	from_asset              TEXT NOT NULL,
+	to_asset                TEXT NOT NULL,
+	price                   REAL NOT NULL,
+	quoted_out_amount       TEXT NOT NULL,
+	signature               TEXT NOT NULL DEFAULT '',
+	status                  TEXT NOT NULL DEFAULT 'pending',
+	realized_out_amount     TEXT NOT NULL DEFAULT '',
+	slippage_realized_bps   REAL NOT NULL DEFAULT 0,
+	fee_lamports            INTEGER NOT NULL DEFAULT 0,
+	confirmed_at            INTEGER NOT NULL DEFAULT 0,
+	error                   TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_swaps_ts ON swaps(ts);
+`
+
+// DB is a handle to the trade journal's SQLite database.
+type DB struct {
+	sqlDB *sql.DB
+}
+
+// Open opens (creating if necessary) the SQLite database at path and applies
+// the journal schema. path may be ":memory:" for an ephemeral journal.
+func Open(path string) (*DB, error) {
+	sqlDB, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("journal: failed to open database: %w", err)
+	}
+
+	// modernc.org/sqlite is CGo-free but doesn't support concurrent writers;
+	// SQLite itself is fine with this since the bot only ever has one
+	// writer goroutine at a time.
+	sqlDB.SetMaxOpenConns(1)
+
+	if _, err := sqlDB.Exec(schema); err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("journal: failed to apply schema: %w", err)
+	}
+
+	return &DB{sqlDB: sqlDB}, nil
+}
+
+// Close closes the underlying database handle.
+func (db *DB) Close() error {
+	return db.sqlDB.Close()
+}
@@ -0,0 +1,149 @@
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Swap status values stored in the swaps table.
+const (
+	StatusPending   = "pending"
+	StatusConfirmed = "confirmed"
+	StatusFailed    = "failed"
+)
+
+// QuoteEntry records a single quote fetched from the price/swap APIs.
+type QuoteEntry struct {
+	CorrelationID string
+	Time          time.Time
+	InputMint     string
+	OutputMint    string
+	InAmount      string
+	OutAmount     string
+	SlippageBps   int
+}
+
+// RecordQuote appends a quote to the journal.
+func (db *DB) RecordQuote(ctx context.Context, e QuoteEntry) error {
+	_, err := db.sqlDB.ExecContext(ctx,
+		`INSERT INTO quotes (correlation_id, ts, input_mint, output_mint, in_amount, out_amount, slippage_bps)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.CorrelationID, e.Time.Unix(), e.InputMint, e.OutputMint, e.InAmount, e.OutAmount, e.SlippageBps)
+	if err != nil {
+		return fmt.Errorf("journal: failed to record quote: %w", err)
+	}
+	return nil
+}
+
+// DecisionEntry records a strategy decision, whether or not it resulted in
+// a swap.
+type DecisionEntry struct {
+	CorrelationID string
+	Time          time.Time
+	Action        string // "hold", "buy_sol", or "sell_sol"
+	Reason        string
+	Price         float64
+}
+
+// RecordDecision appends a swap decision to the journal.
+func (db *DB) RecordDecision(ctx context.Context, e DecisionEntry) error {
+	_, err := db.sqlDB.ExecContext(ctx,
+		`INSERT INTO decisions (correlation_id, ts, action, reason, price) VALUES (?, ?, ?, ?, ?)`,
+		e.CorrelationID, e.Time.Unix(), e.Action, e.Reason, e.Price)
+	if err != nil {
+		return fmt.Errorf("journal: failed to record decision: %w", err)
+	}
+	return nil
+}
+
+// SwapEntry records a swap submission before its outcome is known.
+type SwapEntry struct {
+	CorrelationID   string
+	Time            time.Time
+	FromAsset       string
+	ToAsset         string
+	Price           float64
+	QuotedOutAmount string
+}
+
+// RecordSwap inserts a pending swap row and returns its ID, to be passed to
+// ConfirmSwap or FailSwap once the outcome is known.
+func (db *DB) RecordSwap(ctx context.Context, e SwapEntry) (int64, error) {
+	res, err := db.sqlDB.ExecContext(ctx,
+		`INSERT INTO swaps (correlation_id, ts, from_asset, to_asset, price, quoted_out_amount, status)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.CorrelationID, e.Time.Unix(), e.FromAsset, e.ToAsset, e.Price, e.QuotedOutAmount, StatusPending)
+	if err != nil {
+		return 0, fmt.Errorf("journal: failed to record swap: %w", err)
+	}
+	return res.LastInsertId()
+}
+
+// ConfirmSwap marks a swap confirmed on-chain and records its realized
+// outcome.
+func (db *DB) ConfirmSwap(ctx context.Context, id int64, signature, realizedOutAmount string, slippageRealizedBps float64, feeLamports uint64, confirmedAt time.Time) error {
+	_, err := db.sqlDB.ExecContext(ctx,
+		`UPDATE swaps SET status = ?, signature = ?, realized_out_amount = ?, slippage_realized_bps = ?, fee_lamports = ?, confirmed_at = ?
+		 WHERE id = ?`,
+		StatusConfirmed, signature, realizedOutAmount, slippageRealizedBps, feeLamports, confirmedAt.Unix(), id)
+	if err != nil {
+		return fmt.Errorf("journal: failed to confirm swap %d: %w", id, err)
+	}
+	return nil
+}
+
+// FailSwap marks a swap failed, recording why.
+func (db *DB) FailSwap(ctx context.Context, id int64, signature, reason string) error {
+	_, err := db.sqlDB.ExecContext(ctx,
+		`UPDATE swaps SET status = ?, signature = ?, error = ? WHERE id = ?`,
+		StatusFailed, signature, reason, id)
+	if err != nil {
+		return fmt.Errorf("journal: failed to fail swap %d: %w", id, err)
+	}
+	return nil
+}
+
+// LastConfirmedSwapPrice returns the price of the most recently confirmed
+// swap, used to rehydrate TradingBot.lastSwapPrice on startup. ok is false
+// if the journal has no confirmed swaps yet.
+func (db *DB) LastConfirmedSwapPrice(ctx context.Context) (price float64, ok bool, err error) {
+	row := db.sqlDB.QueryRowContext(ctx,
+		`SELECT price FROM swaps WHERE status = ? ORDER BY ts DESC LIMIT 1`, StatusConfirmed)
+	if err := row.Scan(&price); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("journal: failed to load last swap price: %w", err)
+	}
+	return price, true, nil
+}
+
+// LastConfirmedSwapAsset returns the asset acquired by the most recently
+// confirmed swap, i.e. whatever's currently being held. ok is false if the
+// journal has no confirmed swaps yet.
+func (db *DB) LastConfirmedSwapAsset(ctx context.Context) (asset string, ok bool, err error) {
+	row := db.sqlDB.QueryRowContext(ctx,
+		`SELECT to_asset FROM swaps WHERE status = ? ORDER BY ts DESC LIMIT 1`, StatusConfirmed)
+	if err := row.Scan(&asset); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("journal: failed to load last swap asset: %w", err)
+	}
+	return asset, true, nil
+}
+
+// SwapCountSince returns how many confirmed swaps were recorded at or after
+// since, used to rehydrate TradingBot.swapCount on startup.
+func (db *DB) SwapCountSince(ctx context.Context, since time.Time) (int, error) {
+	var count int
+	row := db.sqlDB.QueryRowContext(ctx,
+		`SELECT COUNT(*) FROM swaps WHERE status = ? AND ts >= ?`, StatusConfirmed, since.Unix())
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("journal: failed to count swaps: %w", err)
+	}
+	return count, nil
+}
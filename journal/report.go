@@ -0,0 +1,109 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Report summarizes the journal's trade history for `bot report`.
+type Report struct {
+	ConfirmedSwaps             int
+	FailedSwaps                int
+	CompletedRoundTrips        int
+	WinRate                    float64 // fraction of completed round trips that were profitable
+	AverageSlippageRealizedBps float64
+	RealizedPnLPercent         float64 // compounded return across all completed round trips
+	UnrealizedPnLPercent       float64 // open position vs. its entry price, if any
+	SwapsPerDay                map[string]int
+}
+
+type confirmedSwap struct {
+	ts        time.Time
+	fromAsset string
+	price     float64
+	slippage  float64
+}
+
+// GenerateReport computes realized PnL, win rate, slippage, and swap
+// histograms from confirmed swaps. currentPrice and currentAsset are used to
+// estimate unrealized PnL on whatever's currently being held.
+func (db *DB) GenerateReport(ctx context.Context, currentPrice float64, currentAsset string) (*Report, error) {
+	rows, err := db.sqlDB.QueryContext(ctx,
+		`SELECT ts, from_asset, price, slippage_realized_bps FROM swaps WHERE status = ? ORDER BY ts ASC`,
+		StatusConfirmed)
+	if err != nil {
+		return nil, fmt.Errorf("journal: failed to query swaps: %w", err)
+	}
+	defer rows.Close()
+
+	var swaps []confirmedSwap
+	var slippageSum float64
+	swapsPerDay := map[string]int{}
+
+	for rows.Next() {
+		var unixTS int64
+		var s confirmedSwap
+		if err := rows.Scan(&unixTS, &s.fromAsset, &s.price, &s.slippage); err != nil {
+			return nil, fmt.Errorf("journal: failed to scan swap row: %w", err)
+		}
+		s.ts = time.Unix(unixTS, 0)
+		swaps = append(swaps, s)
+		slippageSum += s.slippage
+		swapsPerDay[s.ts.Format("2006-01-02")]++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("journal: failed to read swaps: %w", err)
+	}
+
+	var failedCount int
+	if err := db.sqlDB.QueryRowContext(ctx, `SELECT COUNT(*) FROM swaps WHERE status = ?`, StatusFailed).Scan(&failedCount); err != nil {
+		return nil, fmt.Errorf("journal: failed to count failed swaps: %w", err)
+	}
+
+	report := &Report{
+		ConfirmedSwaps: len(swaps),
+		FailedSwaps:    failedCount,
+		SwapsPerDay:    swapsPerDay,
+	}
+	if len(swaps) > 0 {
+		report.AverageSlippageRealizedBps = slippageSum / float64(len(swaps))
+	}
+
+	// A round trip is a SOL->USDC swap (selling into strength) followed by a
+	// USDC->SOL swap (buying back the dip), matching the threshold strategy
+	// in strategy.ThresholdStrategy.
+	compoundedReturn := 1.0
+	wins := 0
+	for i := 0; i+1 < len(swaps); i++ {
+		sell, buy := swaps[i], swaps[i+1]
+		if sell.fromAsset != "SOL" || buy.fromAsset != "USDC" {
+			continue
+		}
+		roundTripReturn := sell.price / buy.price
+		compoundedReturn *= roundTripReturn
+		if roundTripReturn > 1 {
+			wins++
+		}
+		report.CompletedRoundTrips++
+	}
+	if report.CompletedRoundTrips > 0 {
+		report.WinRate = float64(wins) / float64(report.CompletedRoundTrips)
+		report.RealizedPnLPercent = (compoundedReturn - 1) * 100
+	}
+
+	if len(swaps) > 0 && currentPrice > 0 {
+		entryPrice := swaps[len(swaps)-1].price
+		switch currentAsset {
+		case "USDC":
+			// Holding USDC after selling SOL: gains if price has fallen
+			// further since, making the next buy-back cheaper.
+			report.UnrealizedPnLPercent = (entryPrice/currentPrice - 1) * 100
+		case "SOL":
+			// Holding SOL after buying back: gains if price has risen since.
+			report.UnrealizedPnLPercent = (currentPrice/entryPrice - 1) * 100
+		}
+	}
+
+	return report, nil
+}
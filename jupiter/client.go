@@ -0,0 +1,197 @@
+// Package jupiter is a typed client for the Jupiter v6 swap API
+// (https://station.jup.ag/docs/apis/swap-api), replacing ad-hoc
+// http.Get/http.Post calls with context-aware requests, retries, and the
+// full quote/swap schema.
+package jupiter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is Jupiter's public v6 API, used when Client is constructed
+// without WithBaseURL. Self-hosted Jupiter deployments should override it.
+const DefaultBaseURL = "https://quote-api.jup.ag/v6"
+
+const defaultMaxRetries = 3
+
+// Client is a Jupiter v6 API client. Construct with NewClient.
+type Client struct {
+	baseURL     string
+	bearerToken string
+	httpClient  *http.Client
+	maxRetries  int
+}
+
+// Option configures a Client constructed with NewClient.
+type Option func(*Client)
+
+// WithBaseURL points the client at a self-hosted or alternate Jupiter
+// deployment instead of DefaultBaseURL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = strings.TrimSuffix(baseURL, "/") }
+}
+
+// WithBearerToken attaches an Authorization: Bearer header to every request,
+// as required by Jupiter's paid tiers.
+func WithBearerToken(token string) Option {
+	return func(c *Client) { c.bearerToken = token }
+}
+
+// WithHTTPClient overrides the default HTTP client, e.g. to point at an
+// httptest.Server in tests or to adjust the request timeout.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithMaxRetries overrides how many times a request is retried after a 429
+// or 5xx response, in addition to the initial attempt.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) { c.maxRetries = n }
+}
+
+// NewClient builds a Jupiter API client. baseURL defaults to DefaultBaseURL
+// when empty.
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    DefaultBaseURL,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+		maxRetries: defaultMaxRetries,
+	}
+	if baseURL != "" {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Quote fetches a swap route and price for the given params.
+func (c *Client) Quote(ctx context.Context, params QuoteParams) (*QuoteResponse, error) {
+	q := url.Values{}
+	q.Set("inputMint", params.InputMint)
+	q.Set("outputMint", params.OutputMint)
+	q.Set("amount", strconv.FormatUint(params.Amount, 10))
+	if params.SlippageBps > 0 {
+		q.Set("slippageBps", strconv.Itoa(params.SlippageBps))
+	}
+	if params.DynamicSlippage {
+		q.Set("dynamicSlippage", "true")
+	}
+	if params.SwapMode != "" {
+		q.Set("swapMode", params.SwapMode)
+	}
+	if params.OnlyDirectRoutes {
+		q.Set("onlyDirectRoutes", "true")
+	}
+	if params.AsLegacyTransaction {
+		q.Set("asLegacyTransaction", "true")
+	}
+	if params.MaxAccounts > 0 {
+		q.Set("maxAccounts", strconv.Itoa(params.MaxAccounts))
+	}
+	if params.PlatformFeeBps > 0 {
+		q.Set("platformFeeBps", strconv.Itoa(params.PlatformFeeBps))
+	}
+	if len(params.ExcludeDexes) > 0 {
+		q.Set("excludeDexes", strings.Join(params.ExcludeDexes, ","))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/quote?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("jupiter: failed to build quote request: %w", err)
+	}
+
+	var quote QuoteResponse
+	if err := c.doJSON(req, nil, &quote); err != nil {
+		return nil, fmt.Errorf("jupiter: quote failed: %w", err)
+	}
+	return &quote, nil
+}
+
+// Swap builds a versioned swap transaction for a previously-fetched quote.
+// The returned transaction is unsigned; callers are responsible for signing
+// and submitting it.
+func (c *Client) Swap(ctx context.Context, params SwapParams) (*SwapResponse, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("jupiter: failed to marshal swap request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/swap", nil)
+	if err != nil {
+		return nil, fmt.Errorf("jupiter: failed to build swap request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var swap SwapResponse
+	if err := c.doJSON(req, body, &swap); err != nil {
+		return nil, fmt.Errorf("jupiter: swap failed: %w", err)
+	}
+	return &swap, nil
+}
+
+// doJSON executes req with retries and decodes the JSON response body into
+// out. body is re-attached to req before every attempt since the first
+// attempt consumes it.
+func (c *Client) doJSON(req *http.Request, body []byte, out interface{}) error {
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := time.Duration(1<<uint(attempt-1)) * 250 * time.Millisecond
+			select {
+			case <-req.Context().Done():
+				return req.Context().Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		attemptReq := req.Clone(req.Context())
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+			attemptReq.ContentLength = int64(len(body))
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to read response body: %w", err)
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			lastErr = fmt.Errorf("received status %d: %s", resp.StatusCode, string(respBody))
+			continue
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("received status %d: %s", resp.StatusCode, string(respBody))
+		}
+
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("giving up after %d attempts: %w", c.maxRetries+1, lastErr)
+}
@@ -0,0 +1,151 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(baseURL string, opts ...Option) *Client {
+	opts = append([]Option{WithHTTPClient(&http.Client{Timeout: 5 * time.Second})}, opts...)
+	return NewClient(baseURL, opts...)
+}
+
+func TestClient_Quote_EncodesQueryParams(t *testing.T) {
+	var gotQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQuery = r.URL.Query()
+		json.NewEncoder(w).Encode(QuoteResponse{InputMint: "in", OutputMint: "out"})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	_, err := client.Quote(context.Background(), QuoteParams{
+		InputMint:        "So11111111111111111111111111111111111111112",
+		OutputMint:       "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		Amount:           1_000_000,
+		SlippageBps:      50,
+		DynamicSlippage:  true,
+		OnlyDirectRoutes: true,
+		MaxAccounts:      20,
+		PlatformFeeBps:   10,
+		ExcludeDexes:     []string{"Raydium", "Orca"},
+	})
+	if err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+
+	want := map[string]string{
+		"inputMint":        "So11111111111111111111111111111111111111112",
+		"outputMint":       "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		"amount":           "1000000",
+		"slippageBps":      "50",
+		"dynamicSlippage":  "true",
+		"onlyDirectRoutes": "true",
+		"maxAccounts":      "20",
+		"platformFeeBps":   "10",
+		"excludeDexes":     "Raydium,Orca",
+	}
+	for key, wantVal := range want {
+		if got := gotQuery.Get(key); got != wantVal {
+			t.Errorf("query param %q = %q, want %q", key, got, wantVal)
+		}
+	}
+}
+
+func TestClient_Quote_RetriesOn5xxThenSucceeds(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(QuoteResponse{InputMint: "in", OutputMint: "out"})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, WithMaxRetries(3))
+	quote, err := client.Quote(context.Background(), QuoteParams{InputMint: "in", OutputMint: "out", Amount: 1})
+	if err != nil {
+		t.Fatalf("Quote returned error: %v", err)
+	}
+	if quote.InputMint != "in" {
+		t.Errorf("InputMint = %q, want %q", quote.InputMint, "in")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("attempts = %d, want 3", got)
+	}
+}
+
+func TestClient_Quote_GivesUpAfterMaxRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, WithMaxRetries(2))
+	_, err := client.Quote(context.Background(), QuoteParams{InputMint: "in", OutputMint: "out", Amount: 1})
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Errorf("attempts = %d, want 3 (1 initial + 2 retries)", got)
+	}
+}
+
+func TestClient_Quote_DoesNotRetryOn4xx(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, WithMaxRetries(3))
+	_, err := client.Quote(context.Background(), QuoteParams{InputMint: "in", OutputMint: "out", Amount: 1})
+	if err == nil {
+		t.Fatal("expected an error for a 400 response, got nil")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("attempts = %d, want 1 (4xx responses aren't retried)", got)
+	}
+}
+
+func TestClient_Swap_SendsJSONBodyAndBearerToken(t *testing.T) {
+	var gotAuth string
+	var gotBody SwapParams
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Errorf("failed to decode swap request body: %v", err)
+		}
+		json.NewEncoder(w).Encode(SwapResponse{SwapTransaction: "base64tx", LastValidBlockHeight: 123})
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL, WithBearerToken("test-token"))
+	swap, err := client.Swap(context.Background(), SwapParams{
+		QuoteResponse: QuoteResponse{InputMint: "in", OutputMint: "out"},
+		UserPublicKey: "somepubkey",
+	})
+	if err != nil {
+		t.Fatalf("Swap returned error: %v", err)
+	}
+
+	if gotAuth != "Bearer test-token" {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, "Bearer test-token")
+	}
+	if gotBody.UserPublicKey != "somepubkey" {
+		t.Errorf("UserPublicKey = %q, want %q", gotBody.UserPublicKey, "somepubkey")
+	}
+	if swap.SwapTransaction != "base64tx" {
+		t.Errorf("SwapTransaction = %q, want %q", swap.SwapTransaction, "base64tx")
+	}
+}
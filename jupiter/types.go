@@ -0,0 +1,79 @@
+package jupiter
+
+// QuoteParams are the query parameters accepted by the Jupiter v6 /quote
+// endpoint. See https://station.jup.ag/docs/apis/swap-api for the full
+// field reference.
+type QuoteParams struct {
+	InputMint           string
+	OutputMint          string
+	Amount              uint64
+	SlippageBps         int
+	DynamicSlippage     bool
+	SwapMode            string // "ExactIn" (default) or "ExactOut"
+	OnlyDirectRoutes    bool
+	AsLegacyTransaction bool
+	MaxAccounts         int
+	PlatformFeeBps      int
+	ExcludeDexes        []string
+}
+
+// PlatformFee describes the platform fee Jupiter deducted from the route, if
+// PlatformFeeBps was requested.
+type PlatformFee struct {
+	Amount string `json:"amount"`
+	FeeBps int    `json:"feeBps"`
+}
+
+// SwapInfo describes a single hop within a RoutePlan entry.
+type SwapInfo struct {
+	AmmKey     string `json:"ammKey"`
+	Label      string `json:"label"`
+	InputMint  string `json:"inputMint"`
+	OutputMint string `json:"outputMint"`
+	InAmount   string `json:"inAmount"`
+	OutAmount  string `json:"outAmount"`
+	FeeAmount  string `json:"feeAmount"`
+	FeeMint    string `json:"feeMint"`
+}
+
+// RoutePlanStep is one hop of the route Jupiter selected for a quote.
+type RoutePlanStep struct {
+	SwapInfo SwapInfo `json:"swapInfo"`
+	Percent  int      `json:"percent"`
+}
+
+// QuoteResponse is the full Jupiter v6 /quote response.
+type QuoteResponse struct {
+	InputMint            string          `json:"inputMint"`
+	InAmount             string          `json:"inAmount"`
+	OutputMint           string          `json:"outputMint"`
+	OutAmount            string          `json:"outAmount"`
+	OtherAmountThreshold string          `json:"otherAmountThreshold"`
+	SwapMode             string          `json:"swapMode"`
+	SlippageBps          int             `json:"slippageBps"`
+	PlatformFee          *PlatformFee    `json:"platformFee,omitempty"`
+	PriceImpactPct       string          `json:"priceImpactPct"`
+	RoutePlan            []RoutePlanStep `json:"routePlan"`
+	ContextSlot          uint64          `json:"contextSlot,omitempty"`
+	TimeTaken            float64         `json:"timeTaken,omitempty"`
+}
+
+// SwapParams are the body fields accepted by the Jupiter v6 /swap endpoint.
+type SwapParams struct {
+	QuoteResponse                 QuoteResponse `json:"quoteResponse"`
+	UserPublicKey                 string        `json:"userPublicKey"`
+	WrapAndUnwrapSol              bool          `json:"wrapAndUnwrapSol"`
+	AsLegacyTransaction           bool          `json:"asLegacyTransaction,omitempty"`
+	DynamicComputeUnitLimit       bool          `json:"dynamicComputeUnitLimit,omitempty"`
+	ComputeUnitPriceMicroLamports uint64        `json:"computeUnitPriceMicroLamports,omitempty"`
+	PrioritizationFeeLamports     uint64        `json:"prioritizationFeeLamports,omitempty"`
+	DynamicSlippage               bool          `json:"dynamicSlippage,omitempty"`
+	FeeAccount                    string        `json:"feeAccount,omitempty"`
+}
+
+// SwapResponse is the full Jupiter v6 /swap response.
+type SwapResponse struct {
+	SwapTransaction           string `json:"swapTransaction"`
+	LastValidBlockHeight      uint64 `json:"lastValidBlockHeight"`
+	PrioritizationFeeLamports uint64 `json:"prioritizationFeeLamports,omitempty"`
+}
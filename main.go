@@ -1,256 +1,470 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
+	"log/slog"
 	"os"
 	"strconv"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/nakul91/solana-trading-bot/journal"
+	"github.com/nakul91/solana-trading-bot/jupiter"
+	"github.com/nakul91/solana-trading-bot/metrics"
+	"github.com/nakul91/solana-trading-bot/oracle"
+	"github.com/nakul91/solana-trading-bot/strategy"
+	"github.com/nakul91/solana-trading-bot/submit"
 )
 
 const (
 	SOL_MINT  = "So11111111111111111111111111111111111111112"
 	USDC_MINT = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
 
-	JUPITER_QUOTE_API = "https://quote-api.jup.ag/v6/quote"
-	JUPITER_SWAP_API  = "https://quote-api.jup.ag/v6/swap"
+	// Defaults used when the matching Config field is left unset (zero value).
+	defaultOracleMaxStalenessSeconds = 60
+	defaultOracleMaxDeviationPercent = 5.0
+	defaultJournalPath               = "trades.db"
+	defaultStrategyName              = "threshold"
+	defaultPriceHistorySize          = 200
+	defaultEMAFastPeriod             = 12
+	defaultEMASlowPeriod             = 26
+	defaultRSIPeriod                 = 14
+	defaultRSIBuyThreshold           = 30.0
+	defaultRSISellThreshold          = 70.0
+	defaultBollingerPeriod           = 20
+	defaultBollingerK                = 2.0
+	defaultHealthzMaxStaleTicks      = 3
 )
 
 type Config struct {
-	WalletAddress              string  `json:"wallet_address"`
-	PrivateKey                 string  `json:"private_key"`
-	RpcURL                     string  `json:"rpc_url"`
-	InitialBalanceUSD          float64 `json:"initial_balance_usd"`
-	PriceCheckIntervalSeconds  int     `json:"price_check_interval_seconds"`
-	SwapThresholdMinPercent    float64 `json:"swap_threshold_min_percent"`
-	SwapThresholdMaxPercent    float64 `json:"swap_threshold_max_percent"`
-	MaxSwapsPerDay             int     `json:"max_swaps_per_day"`
-	SlippageBps                int     `json:"slippage_bps"`
-	SimulateMode               bool    `json:"simulate_mode"`
-	PriorityFeeMicrolamports   uint64  `json:"priority_fee_microlamports"`
-}
-
-
-type QuoteResponse struct {
-	InputMint        string `json:"inputMint"`
-	InAmount         string `json:"inAmount"`
-	OutputMint       string `json:"outputMint"`
-	OutAmount        string `json:"outAmount"`
-	OtherAmountThreshold string `json:"otherAmountThreshold"`
-	SwapMode         string `json:"swapMode"`
-	SlippageBps      int    `json:"slippageBps"`
-}
-
-type SwapRequest struct {
-	QuoteResponse         QuoteResponse `json:"quoteResponse"`
-	UserPublicKey         string        `json:"userPublicKey"`
-	WrapAndUnwrapSol      bool          `json:"wrapAndUnwrapSol"`
-	PriorityFeeLamports   uint64        `json:"priorityFeeLamports,omitempty"`
-	DynamicComputeUnitLimit bool        `json:"dynamicComputeUnitLimit,omitempty"`
-}
-
-type SwapResponse struct {
-	SwapTransaction string `json:"swapTransaction"`
-	LastValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+	WalletAddress             string   `json:"wallet_address"`
+	PrivateKey                string   `json:"private_key"`
+	Mnemonic                  string   `json:"mnemonic"`
+	DerivationPath            string   `json:"derivation_path"`
+	RpcURL                    string   `json:"rpc_url"`
+	RpcEndpoints              []string `json:"rpc_endpoints"`
+	JitoTipLamports           uint64   `json:"jito_tip_lamports"`
+	JitoTipAccount            string   `json:"jito_tip_account"`
+	JitoBundleURL             string   `json:"jito_bundle_url"`
+	JupiterBaseURL            string   `json:"jupiter_base_url"`
+	JupiterAPIKey             string   `json:"jupiter_api_key"`
+	EnablePythOracle          bool     `json:"enable_pyth_oracle"`
+	EnableBirdeyeOracle       bool     `json:"enable_birdeye_oracle"`
+	BirdeyeAPIKey             string   `json:"birdeye_api_key"`
+	EnableCoinGeckoOracle     bool     `json:"enable_coingecko_oracle"`
+	CoinGeckoAPIKey           string   `json:"coingecko_api_key"`
+	OracleMaxStalenessSeconds int      `json:"oracle_max_staleness_seconds"`
+	OracleMaxDeviationPercent float64  `json:"oracle_max_deviation_percent"`
+	OracleMaxSpreadBps        int      `json:"oracle_max_spread_bps"`
+	JournalPath               string   `json:"journal_path"`
+	InitialBalanceUSD         float64  `json:"initial_balance_usd"`
+	PriceCheckIntervalSeconds int      `json:"price_check_interval_seconds"`
+	SwapThresholdMinPercent   float64  `json:"swap_threshold_min_percent"`
+	SwapThresholdMaxPercent   float64  `json:"swap_threshold_max_percent"`
+	MaxSwapsPerDay            int      `json:"max_swaps_per_day"`
+	SlippageBps               int      `json:"slippage_bps"`
+	SimulateMode              bool     `json:"simulate_mode"`
+	PriorityFeeMicrolamports  uint64   `json:"priority_fee_microlamports"`
+
+	// Strategy selects which strategy.Strategy implementation drives swap
+	// decisions: "threshold" (default), "ema", "rsi", or "bollinger". Each
+	// strategy reads only the parameter block it needs below.
+	Strategy         string `json:"strategy"`
+	PriceHistorySize int    `json:"price_history_size"`
+
+	EMAFastPeriod int `json:"ema_fast_period"`
+	EMASlowPeriod int `json:"ema_slow_period"`
+
+	RSIPeriod        int     `json:"rsi_period"`
+	RSIBuyThreshold  float64 `json:"rsi_buy_threshold"`
+	RSISellThreshold float64 `json:"rsi_sell_threshold"`
+
+	BollingerPeriod int     `json:"bollinger_period"`
+	BollingerK      float64 `json:"bollinger_k"`
+
+	// MetricsListenAddr, if set, starts a /metrics and /healthz HTTP server
+	// on this address (e.g. ":9090"). Left unset, no server is started.
+	MetricsListenAddr string `json:"metrics_listen_addr"`
+	// HealthzMaxStaleTicks is how many price-check intervals may pass
+	// without a successful tick before /healthz reports unhealthy.
+	HealthzMaxStaleTicks int `json:"healthz_max_stale_ticks"`
 }
 
 type TradingBot struct {
-	config          Config
-	currentAsset    string    // "SOL" or "USDC"
-	balance         float64   // Current balance in USD
-	lastSwapPrice   float64   // Last price when swap occurred
-	swapCount       int       // Number of swaps today
-	lastSwapReset   time.Time // Last time swap count was reset
-	rpcClient       *rpc.Client
-	wallet          solana.PrivateKey
+	config        Config
+	currentAsset  string    // "SOL" or "USDC"
+	balance       float64   // Current balance in USD
+	lastSwapPrice float64   // Last price when swap occurred
+	swapCount     int       // Number of swaps today
+	lastSwapReset time.Time // Last time swap count was reset
+	rpcClient     *rpc.Client
+	jupiterClient *jupiter.Client
+	priceOracle   *oracle.MedianOracle
+	journal       *journal.DB
+	submitter     *submit.Submitter
+	priceHistory  *strategy.PriceHistory
+	strategy      strategy.Strategy
+	metricsServer *metrics.Server
+	wallet        solana.PrivateKey
 }
 
 func NewTradingBot(config Config) (*TradingBot, error) {
 	// Initialize Solana RPC client
 	rpcClient := rpc.New(config.RpcURL)
 
-	// Parse base58 private key
-	privateKey, err := solana.PrivateKeyFromBase58(config.PrivateKey)
+	privateKey, err := loadWallet(config)
 	if err != nil {
-		return nil, fmt.Errorf("invalid base58 private key: %w", err)
+		return nil, err
 	}
-	log.Printf("Successfully loaded wallet from base58 private key")
 
 	// Verify public key matches wallet address (if provided)
 	expectedPubkey := privateKey.PublicKey()
 	if config.WalletAddress != "" && expectedPubkey.String() != config.WalletAddress {
-		log.Printf("Warning: Generated public key (%s) doesn't match provided wallet address (%s)",
-			expectedPubkey.String(), config.WalletAddress)
-		log.Printf("Using generated public key: %s", expectedPubkey.String())
+		slog.Warn("generated public key doesn't match configured wallet address, using generated key",
+			"generated_pubkey", expectedPubkey.String(), "configured_wallet_address", config.WalletAddress)
+	}
+
+	jupiterOpts := []jupiter.Option{}
+	if config.JupiterAPIKey != "" {
+		jupiterOpts = append(jupiterOpts, jupiter.WithBearerToken(config.JupiterAPIKey))
+	}
+	jupiterClient := jupiter.NewClient(config.JupiterBaseURL, jupiterOpts...)
+
+	journalPath := config.JournalPath
+	if journalPath == "" {
+		journalPath = defaultJournalPath
+	}
+	journalDB, err := journal.Open(journalPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trade journal: %w", err)
+	}
+
+	lastSwapPrice, swapCount, lastSwapReset, err := rehydrateFromJournal(journalDB)
+	if err != nil {
+		journalDB.Close()
+		return nil, fmt.Errorf("failed to rehydrate state from trade journal: %w", err)
+	}
+
+	rpcEndpoints := config.RpcEndpoints
+	if len(rpcEndpoints) == 0 {
+		rpcEndpoints = []string{config.RpcURL}
+	}
+
+	historySize := config.PriceHistorySize
+	if historySize == 0 {
+		historySize = defaultPriceHistorySize
+	}
+
+	var metricsServer *metrics.Server
+	if config.MetricsListenAddr != "" {
+		staleTicks := config.HealthzMaxStaleTicks
+		if staleTicks == 0 {
+			staleTicks = defaultHealthzMaxStaleTicks
+		}
+		maxTickAge := time.Duration(config.PriceCheckIntervalSeconds*staleTicks) * time.Second
+		metricsServer = metrics.NewServer(config.MetricsListenAddr, maxTickAge)
 	}
 
 	return &TradingBot{
 		config:        config,
 		currentAsset:  "SOL",
 		balance:       config.InitialBalanceUSD,
-		lastSwapPrice: 0,
-		swapCount:     0,
-		lastSwapReset: time.Now(),
+		lastSwapPrice: lastSwapPrice,
+		swapCount:     swapCount,
+		lastSwapReset: lastSwapReset,
 		rpcClient:     rpcClient,
+		jupiterClient: jupiterClient,
+		priceOracle:   newPriceOracle(config, jupiterClient),
+		journal:       journalDB,
+		submitter:     submit.NewSubmitter(rpcEndpoints),
+		priceHistory:  strategy.NewPriceHistory(historySize),
+		strategy:      newStrategy(config),
+		metricsServer: metricsServer,
 		wallet:        privateKey,
 	}, nil
 }
 
-func loadConfig(filename string) (Config, error) {
-	var config Config
-
-	file, err := os.ReadFile(filename)
-	if err != nil {
-		return config, fmt.Errorf("failed to read config file: %w", err)
+// newStrategy builds the strategy.Strategy selected by config.Strategy,
+// falling back to the original threshold rule if unset or unrecognized.
+func newStrategy(config Config) strategy.Strategy {
+	name := config.Strategy
+	if name == "" {
+		name = defaultStrategyName
 	}
 
-	err = json.Unmarshal(file, &config)
-	if err != nil {
-		return config, fmt.Errorf("failed to parse config file: %w", err)
+	switch name {
+	case "ema":
+		fast, slow := config.EMAFastPeriod, config.EMASlowPeriod
+		if fast == 0 {
+			fast = defaultEMAFastPeriod
+		}
+		if slow == 0 {
+			slow = defaultEMASlowPeriod
+		}
+		return strategy.EMACrossoverStrategy{FastPeriod: fast, SlowPeriod: slow}
+	case "rsi":
+		period := config.RSIPeriod
+		if period == 0 {
+			period = defaultRSIPeriod
+		}
+		buyBelow := config.RSIBuyThreshold
+		if buyBelow == 0 {
+			buyBelow = defaultRSIBuyThreshold
+		}
+		sellAbove := config.RSISellThreshold
+		if sellAbove == 0 {
+			sellAbove = defaultRSISellThreshold
+		}
+		return strategy.RSIMeanReversionStrategy{Period: period, BuyBelow: buyBelow, SellAbove: sellAbove}
+	case "bollinger":
+		period := config.BollingerPeriod
+		if period == 0 {
+			period = defaultBollingerPeriod
+		}
+		k := config.BollingerK
+		if k == 0 {
+			k = defaultBollingerK
+		}
+		return strategy.BollingerBreakoutStrategy{Period: period, K: k}
+	default:
+		return strategy.ThresholdStrategy{MinPercent: config.SwapThresholdMinPercent}
 	}
+}
 
-	return config, nil
+// jitoConfigured reports whether the bot is set up to also submit swap
+// transactions as a tipped Jito bundle, for better landing rates during
+// congestion.
+func (tb *TradingBot) jitoConfigured() bool {
+	return tb.config.JitoBundleURL != "" && tb.config.JitoTipAccount != "" && tb.config.JitoTipLamports > 0
 }
 
-func (tb *TradingBot) resetDailySwapCount() {
-	now := time.Now()
-	if now.Sub(tb.lastSwapReset) >= 24*time.Hour {
-		tb.swapCount = 0
-		tb.lastSwapReset = now
-		log.Println("Daily swap count reset")
+func (tb *TradingBot) jitoConfig() submit.JitoConfig {
+	return submit.JitoConfig{
+		BundleURL:   tb.config.JitoBundleURL,
+		TipAccount:  tb.config.JitoTipAccount,
+		TipLamports: tb.config.JitoTipLamports,
 	}
 }
 
-func getSolanaPrice(slippageBps int) (float64, error) {
-	// Get quote for 1 SOL to USDC
-	url := fmt.Sprintf("%s?inputMint=%s&outputMint=%s&amount=1000000000&slippageBps=%d",
-		JUPITER_QUOTE_API, SOL_MINT, USDC_MINT, slippageBps)
+// rehydrateFromJournal restores the last swap price and today's swap count
+// from the trade journal, so a restart doesn't lose the threshold logic's
+// reference price or reset the daily swap limit early.
+func rehydrateFromJournal(journalDB *journal.DB) (lastSwapPrice float64, swapCount int, lastSwapReset time.Time, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	now := time.Now().UTC()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
 
-	resp, err := http.Get(url)
+	price, ok, err := journalDB.LastConfirmedSwapPrice(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get quote: %w", err)
+		return 0, 0, time.Time{}, err
+	}
+	if ok {
+		lastSwapPrice = price
+		slog.Info("rehydrated last swap price from journal", "last_swap_price", lastSwapPrice)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	swapCount, err = journalDB.SwapCountSince(ctx, startOfDay)
 	if err != nil {
-		return 0, fmt.Errorf("failed to read response: %w", err)
+		return 0, 0, time.Time{}, err
+	}
+	if swapCount > 0 {
+		slog.Info("rehydrated today's swap count from journal", "swap_count", swapCount)
 	}
 
-	var quote QuoteResponse
-	err = json.Unmarshal(body, &quote)
-	if err != nil {
-		return 0, fmt.Errorf("failed to parse quote response: %w", err)
+	return lastSwapPrice, swapCount, startOfDay, nil
+}
+
+// newPriceOracle builds the MedianOracle used for swap-decision price
+// discovery from whichever sources are enabled in config. Jupiter is always
+// included since the bot already holds a client for it, but it's the least
+// independent source (it's also used to execute swaps) so it should never
+// be the only one relied on in production.
+func newPriceOracle(config Config, jupiterClient *jupiter.Client) *oracle.MedianOracle {
+	sources := map[string]oracle.PriceOracle{
+		"jupiter": oracle.NewJupiterOracle(jupiterClient),
+	}
+	if config.EnablePythOracle {
+		sources["pyth"] = oracle.NewPythOracle("", map[string]string{SOL_MINT: oracle.PythSOLUSDFeedID})
+	}
+	if config.EnableBirdeyeOracle {
+		sources["birdeye"] = oracle.NewBirdeyeOracle("", config.BirdeyeAPIKey)
+	}
+	if config.EnableCoinGeckoOracle {
+		sources["coingecko"] = oracle.NewCoinGeckoOracle("", config.CoinGeckoAPIKey, map[string]string{SOL_MINT: "solana"})
 	}
 
-	// Convert outAmount from lamports to USDC (6 decimals)
-	outAmountInt, err := strconv.ParseInt(quote.OutAmount, 10, 64)
-	if err != nil {
-		return 0, fmt.Errorf("failed to parse output amount: %w", err)
+	maxStalenessSeconds := config.OracleMaxStalenessSeconds
+	if maxStalenessSeconds == 0 {
+		maxStalenessSeconds = defaultOracleMaxStalenessSeconds
+	}
+	maxDeviationPercent := config.OracleMaxDeviationPercent
+	if maxDeviationPercent == 0 {
+		maxDeviationPercent = defaultOracleMaxDeviationPercent
 	}
 
-	price := float64(outAmountInt) / 1000000 // USDC has 6 decimals
-	return price, nil
+	return oracle.NewMedianOracle(sources, time.Duration(maxStalenessSeconds)*time.Second, maxDeviationPercent)
 }
 
-func (tb *TradingBot) shouldSwap(currentPrice float64) (bool, string) {
-	if tb.lastSwapPrice == 0 {
-		tb.lastSwapPrice = currentPrice
-		return false, "Initial price set"
+func loadConfig(filename string) (Config, error) {
+	var config Config
+
+	file, err := os.ReadFile(filename)
+	if err != nil {
+		return config, fmt.Errorf("failed to read config file: %w", err)
 	}
 
-	priceChangePercent := ((currentPrice - tb.lastSwapPrice) / tb.lastSwapPrice) * 100
+	err = json.Unmarshal(file, &config)
+	if err != nil {
+		return config, fmt.Errorf("failed to parse config file: %w", err)
+	}
 
-	minThreshold := tb.config.SwapThresholdMinPercent
-	//maxThreshold := tb.config.SwapThresholdMaxPercent
+	return config, nil
+}
 
-	// If holding SOL and price increased by at least minimum threshold
-	if tb.currentAsset == "SOL" && priceChangePercent >= minThreshold {
-		return true, fmt.Sprintf("SOL price increased by %.2f%% (%.2f -> %.2f), swapping to USDC",
-			priceChangePercent, tb.lastSwapPrice, currentPrice)
+func (tb *TradingBot) resetDailySwapCount() {
+	now := time.Now()
+	if now.Sub(tb.lastSwapReset) >= 24*time.Hour {
+		tb.swapCount = 0
+		tb.lastSwapReset = now
+		slog.Info("daily swap count reset")
 	}
+}
 
-	// If holding USDC and price decreased by at least minimum threshold
-	if tb.currentAsset == "USDC" && priceChangePercent <= -minThreshold {
-		return true, fmt.Sprintf("SOL price decreased by %.2f%% (%.2f -> %.2f), swapping to SOL",
-			priceChangePercent, tb.lastSwapPrice, currentPrice)
+// getSolanaPrice queries the price oracle for SOL/USD. It returns the
+// aggregated result rather than a bare float so callers can inspect
+// confidence before acting on it.
+func (tb *TradingBot) getSolanaPrice(ctx context.Context) (*oracle.MedianResult, error) {
+	result, err := tb.priceOracle.Median(ctx, SOL_MINT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SOL price: %w", err)
 	}
-
-	return false, fmt.Sprintf("Price change %.2f%% doesn't meet swap criteria", priceChangePercent)
+	return result, nil
 }
 
-func (tb *TradingBot) executeSwap(currentPrice float64) error {
+func (tb *TradingBot) executeSwap(ctx context.Context, currentPrice float64, sizeFraction float64) error {
 	// Check daily swap limit
 	if tb.swapCount >= tb.config.MaxSwapsPerDay {
 		return fmt.Errorf("daily swap limit reached (%d/%d)", tb.swapCount, tb.config.MaxSwapsPerDay)
 	}
 
+	correlationID := fmt.Sprintf("swap-%d", time.Now().UnixNano())
+	logger := slog.With("correlation_id", correlationID)
+
+	direction := map[string]string{"SOL": "sell_sol", "USDC": "buy_sol"}[tb.currentAsset]
+
 	var fromMint, toMint string
-	var amount int64
+	var amount uint64
+
+	tradedBalance := tb.balance * sizeFraction
 
 	if tb.currentAsset == "SOL" {
 		// Swap SOL to USDC
 		fromMint = SOL_MINT
 		toMint = USDC_MINT
 		// Convert USD balance to SOL lamports (9 decimals)
-		amount = int64((tb.balance / currentPrice) * 1000000000)
+		amount = uint64((tradedBalance / currentPrice) * 1000000000)
 	} else {
 		// Swap USDC to SOL
 		fromMint = USDC_MINT
 		toMint = SOL_MINT
 		// Convert USD balance to USDC (6 decimals)
-		amount = int64(tb.balance * 1000000)
+		amount = uint64(tradedBalance * 1000000)
 	}
 
 	// Get quote for the swap
-	quoteURL := fmt.Sprintf("%s?inputMint=%s&outputMint=%s&amount=%d&slippageBps=%d",
-		JUPITER_QUOTE_API, fromMint, toMint, amount, tb.config.SlippageBps)
-
-	resp, err := http.Get(quoteURL)
+	quoteStart := time.Now()
+	quote, err := tb.jupiterClient.Quote(ctx, jupiter.QuoteParams{
+		InputMint:   fromMint,
+		OutputMint:  toMint,
+		Amount:      amount,
+		SlippageBps: tb.config.SlippageBps,
+	})
+	metrics.QuoteLatencySeconds.Observe(time.Since(quoteStart).Seconds())
 	if err != nil {
+		metrics.SwapsTotal.WithLabelValues("failed", direction).Inc()
 		return fmt.Errorf("failed to get swap quote: %w", err)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read quote response: %w", err)
-	}
-
-	var quote QuoteResponse
-	err = json.Unmarshal(body, &quote)
+	if err := tb.journal.RecordQuote(ctx, journal.QuoteEntry{
+		CorrelationID: correlationID,
+		Time:          time.Now(),
+		InputMint:     quote.InputMint,
+		OutputMint:    quote.OutputMint,
+		InAmount:      quote.InAmount,
+		OutAmount:     quote.OutAmount,
+		SlippageBps:   quote.SlippageBps,
+	}); err != nil {
+		logger.Warn("failed to journal quote", "error", err)
+	}
+
+	logger.Info("swap quote received",
+		"in_amount", quote.InAmount, "input_mint", quote.InputMint,
+		"out_amount", quote.OutAmount, "output_mint", quote.OutputMint)
+
+	swapID, err := tb.journal.RecordSwap(ctx, journal.SwapEntry{
+		CorrelationID:   correlationID,
+		Time:            time.Now(),
+		FromAsset:       tb.currentAsset,
+		ToAsset:         map[string]string{"SOL": "USDC", "USDC": "SOL"}[tb.currentAsset],
+		Price:           currentPrice,
+		QuotedOutAmount: quote.OutAmount,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to parse quote response: %w", err)
+		logger.Warn("failed to journal pending swap", "error", err)
 	}
 
-	log.Printf("Swap quote received: %s %s -> %s %s",
-		quote.InAmount, quote.InputMint[:8]+"...",
-		quote.OutAmount, quote.OutputMint[:8]+"...")
-
+	var signature string
 	if tb.config.SimulateMode {
 		// Simulation mode - don't actually execute swap
-		log.Printf("SIMULATED SWAP: %s -> %s", tb.currentAsset,
-			map[string]string{"SOL": "USDC", "USDC": "SOL"}[tb.currentAsset])
+		logger.Info("simulated swap", "from_asset", tb.currentAsset, "direction", direction)
 	} else {
 		// Execute real swap
-		log.Printf("EXECUTING REAL SWAP: %s -> %s", tb.currentAsset,
-			map[string]string{"SOL": "USDC", "USDC": "SOL"}[tb.currentAsset])
+		logger.Info("executing real swap", "from_asset", tb.currentAsset, "direction", direction)
 
-		err := tb.executeRealSwap(quote)
+		signature, err = tb.executeRealSwap(ctx, *quote, logger)
 		if err != nil {
+			metrics.SwapsTotal.WithLabelValues("failed", direction).Inc()
+			if swapID != 0 {
+				if jerr := tb.journal.FailSwap(ctx, swapID, signature, err.Error()); jerr != nil {
+					logger.Warn("failed to journal failed swap", "error", jerr)
+				}
+			}
 			return fmt.Errorf("real swap execution failed: %w", err)
 		}
-		log.Printf("Swap executed successfully!")
+		logger.Info("swap executed successfully")
+	}
+
+	if swapID != 0 {
+		var slippageRealizedBps float64
+		var feeLamports uint64
+		realizedOut := quote.OutAmount
+		if !tb.config.SimulateMode {
+			sig, err := solana.SignatureFromBase58(signature)
+			if err != nil {
+				logger.Warn("failed to parse swap signature, recording zero slippage/fee", "error", err)
+			} else if realizedOutAmount, fee, err := tb.realizedSwapOutcome(ctx, sig, toMint); err != nil {
+				logger.Warn("failed to compute realized slippage, recording zero slippage/fee", "error", err)
+			} else {
+				feeLamports = fee
+				realizedOut = strconv.FormatUint(realizedOutAmount, 10)
+				if quotedOutAmount, err := strconv.ParseUint(quote.OutAmount, 10, 64); err == nil && quotedOutAmount > 0 {
+					slippageRealizedBps = (float64(quotedOutAmount) - float64(realizedOutAmount)) / float64(quotedOutAmount) * 10000
+				}
+			}
+		}
+		if err := tb.journal.ConfirmSwap(ctx, swapID, signature, realizedOut, slippageRealizedBps, feeLamports, time.Now()); err != nil {
+			logger.Warn("failed to journal confirmed swap", "error", err)
+		}
+		metrics.SlippageRealizedBps.Observe(slippageRealizedBps)
 	}
+	metrics.SwapsTotal.WithLabelValues("success", direction).Inc()
 
 	// Update bot state
 	if tb.currentAsset == "SOL" {
@@ -264,62 +478,34 @@ func (tb *TradingBot) executeSwap(currentPrice float64) error {
 	tb.lastSwapPrice = currentPrice
 	tb.swapCount++
 
-	log.Printf("New balance: $%.2f in %s", tb.balance, tb.currentAsset)
-	log.Printf("Swaps today: %d/%d", tb.swapCount, tb.config.MaxSwapsPerDay)
+	logger.Info("swap complete", "new_balance_usd", tb.balance, "asset", tb.currentAsset,
+		"swaps_today", tb.swapCount, "max_swaps_per_day", tb.config.MaxSwapsPerDay)
 
 	return nil
 }
 
-func (tb *TradingBot) executeRealSwap(quote QuoteResponse) error {
-	// Create swap request
-	swapReq := SwapRequest{
-		QuoteResponse:         quote,
-		UserPublicKey:         tb.wallet.PublicKey().String(),
-		WrapAndUnwrapSol:      true,
-		PriorityFeeLamports:   tb.config.PriorityFeeMicrolamports,
-		DynamicComputeUnitLimit: true,
-	}
-
-	// Marshal swap request to JSON
-	swapReqBytes, err := json.Marshal(swapReq)
-	if err != nil {
-		return fmt.Errorf("failed to marshal swap request: %w", err)
-	}
-
-	// Call Jupiter Swap API
-	resp, err := http.Post(JUPITER_SWAP_API, "application/json", bytes.NewBuffer(swapReqBytes))
-	if err != nil {
-		return fmt.Errorf("failed to call Jupiter swap API: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("Jupiter swap API returned status %d: %s", resp.StatusCode, string(body))
-	}
-
-	// Parse swap response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read swap response: %w", err)
-	}
-
-	var swapResp SwapResponse
-	err = json.Unmarshal(body, &swapResp)
+func (tb *TradingBot) executeRealSwap(ctx context.Context, quote jupiter.QuoteResponse, logger *slog.Logger) (string, error) {
+	swapResp, err := tb.jupiterClient.Swap(ctx, jupiter.SwapParams{
+		QuoteResponse:                 quote,
+		UserPublicKey:                 tb.wallet.PublicKey().String(),
+		WrapAndUnwrapSol:              true,
+		ComputeUnitPriceMicroLamports: tb.config.PriorityFeeMicrolamports,
+		DynamicComputeUnitLimit:       true,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to parse swap response: %w", err)
+		return "", fmt.Errorf("failed to get swap transaction from Jupiter: %w", err)
 	}
 
 	// Decode the transaction
 	txBytes, err := base64.StdEncoding.DecodeString(swapResp.SwapTransaction)
 	if err != nil {
-		return fmt.Errorf("failed to decode transaction: %w", err)
+		return "", fmt.Errorf("failed to decode transaction: %w", err)
 	}
 
 	// Parse transaction
 	tx, err := solana.TransactionFromBytes(txBytes)
 	if err != nil {
-		return fmt.Errorf("failed to parse transaction: %w", err)
+		return "", fmt.Errorf("failed to parse transaction: %w", err)
 	}
 
 	// Sign the transaction
@@ -330,83 +516,128 @@ func (tb *TradingBot) executeRealSwap(quote QuoteResponse) error {
 		return nil
 	})
 	if err != nil {
-		return fmt.Errorf("failed to sign transaction: %w", err)
+		return "", fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
-	// Send transaction
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
+	// Re-signer used by the submitter each time it needs to resubmit against a
+	// fresh blockhash, since the one Jupiter handed back will eventually expire.
+	sign := func(tx *solana.Transaction) error {
+		recent, err := tb.rpcClient.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+		if err != nil {
+			return fmt.Errorf("failed to refresh blockhash: %w", err)
+		}
+		tx.Message.RecentBlockhash = recent.Value.Blockhash
+		if _, err := tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+			if key.Equals(tb.wallet.PublicKey()) {
+				return &tb.wallet
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to re-sign transaction: %w", err)
+		}
+		return nil
+	}
 
-	sig, err := tb.rpcClient.SendTransaction(ctx, tx)
-	if err != nil {
-		return fmt.Errorf("failed to send transaction: %w", err)
+	// Best-effort Jito bundle submission alongside the regular RPC race. A
+	// failure here isn't fatal: the swap can still land through the racing
+	// RPCs, just without the priority a tip would have bought it.
+	if tb.jitoConfigured() {
+		tipTx, err := submit.BuildTipTransaction(ctx, tb.rpcClient, tb.wallet, tb.jitoConfig())
+		if err != nil {
+			logger.Warn("failed to build Jito tip transaction", "error", err)
+		} else if bundleID, err := submit.SubmitBundle(ctx, tb.jitoConfig(), tx, tipTx); err != nil {
+			logger.Warn("failed to submit Jito bundle", "error", err)
+		} else {
+			logger.Info("submitted Jito bundle", "bundle_id", bundleID)
+		}
 	}
 
-	log.Printf("Transaction sent: %s", sig.String())
+	submitStart := time.Now()
+	sig, err := tb.submitter.SubmitAndConfirm(ctx, tx, swapResp.LastValidBlockHeight, sign)
+	metrics.TxConfirmationSeconds.Observe(time.Since(submitStart).Seconds())
+	if err != nil {
+		return "", fmt.Errorf("failed to submit and confirm transaction: %w", err)
+	}
 
-	// Wait for confirmation
-	return tb.waitForConfirmation(ctx, sig, swapResp.LastValidBlockHeight)
+	logger.Info("transaction confirmed", "signature", sig.String())
+	return sig.String(), nil
 }
 
-func (tb *TradingBot) waitForConfirmation(ctx context.Context, signature solana.Signature, lastValidBlockHeight uint64) error {
-	log.Printf("Waiting for transaction confirmation...")
-
-	// Get current block height (for reference)
-	_, err := tb.rpcClient.GetBlockHeight(ctx, rpc.CommitmentFinalized)
+// realizedSwapOutcome computes how much of toMint the wallet actually
+// received in a confirmed swap transaction, and the network fee it paid, by
+// diffing the wallet's balance from the transaction's pre/post metadata.
+// outAmount is in toMint's smallest unit (lamports for SOL, 6-decimal units
+// for USDC), matching quote.OutAmount's units.
+func (tb *TradingBot) realizedSwapOutcome(ctx context.Context, sig solana.Signature, toMint string) (outAmount uint64, feeLamports uint64, err error) {
+	maxSupportedVersion := uint64(0)
+	txResult, err := tb.rpcClient.GetTransaction(ctx, sig, &rpc.GetTransactionOpts{
+		Commitment:                     rpc.CommitmentConfirmed,
+		MaxSupportedTransactionVersion: &maxSupportedVersion,
+	})
 	if err != nil {
-		log.Printf("Warning: failed to get initial block height: %v", err)
+		return 0, 0, fmt.Errorf("failed to fetch confirmed transaction: %w", err)
+	}
+	if txResult.Meta == nil {
+		return 0, 0, fmt.Errorf("confirmed transaction has no metadata")
 	}
 
-	// Wait for confirmation with timeout based on block height
-	ticker := time.NewTicker(2 * time.Second)
-	defer ticker.Stop()
+	wallet := tb.wallet.PublicKey()
 
-	for {
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("transaction confirmation timeout")
-		case <-ticker.C:
-			// Check transaction status
-			status, err := tb.rpcClient.GetSignatureStatuses(ctx, true, signature)
-			if err != nil {
-				log.Printf("Error checking transaction status: %v", err)
-				continue
-			}
-
-			if len(status.Value) > 0 && status.Value[0] != nil {
-				txStatus := status.Value[0]
-				if txStatus.Err != nil {
-					return fmt.Errorf("transaction failed: %v", txStatus.Err)
-				}
-				if txStatus.ConfirmationStatus != "" {
-					log.Printf("Transaction confirmed with status: %s", txStatus.ConfirmationStatus)
-					return nil
-				}
+	if toMint == SOL_MINT {
+		tx, err := txResult.Transaction.GetTransaction()
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to decode confirmed transaction: %w", err)
+		}
+		walletIndex := -1
+		for i, key := range tx.Message.AccountKeys {
+			if key.Equals(wallet) {
+				walletIndex = i
+				break
 			}
+		}
+		if walletIndex < 0 || walletIndex >= len(txResult.Meta.PreBalances) || walletIndex >= len(txResult.Meta.PostBalances) {
+			return 0, txResult.Meta.Fee, fmt.Errorf("wallet account not found in confirmed transaction")
+		}
+		pre, post := txResult.Meta.PreBalances[walletIndex], txResult.Meta.PostBalances[walletIndex]
+		if post <= pre {
+			return 0, txResult.Meta.Fee, fmt.Errorf("wallet SOL balance did not increase (pre=%d, post=%d)", pre, post)
+		}
+		return post - pre, txResult.Meta.Fee, nil
+	}
 
-			// Check if we've exceeded the last valid block height
-			currentHeight, err := tb.rpcClient.GetBlockHeight(ctx, rpc.CommitmentFinalized)
-			if err != nil {
-				log.Printf("Error getting current block height: %v", err)
-				continue
-			}
+	pre := walletTokenBalance(txResult.Meta.PreTokenBalances, wallet, toMint)
+	post := walletTokenBalance(txResult.Meta.PostTokenBalances, wallet, toMint)
+	if post <= pre {
+		return 0, txResult.Meta.Fee, fmt.Errorf("wallet %s balance did not increase (pre=%d, post=%d)", toMint, pre, post)
+	}
+	return post - pre, txResult.Meta.Fee, nil
+}
 
-			if currentHeight > lastValidBlockHeight {
-				return fmt.Errorf("transaction expired (current block: %d, last valid: %d)",
-					currentHeight, lastValidBlockHeight)
-			}
+// walletTokenBalance returns the wallet's balance of mint from a transaction's
+// pre/post token balance list, or 0 if the wallet held no account for that
+// mint at that point (e.g. the account was just created by the swap).
+func walletTokenBalance(balances []rpc.TokenBalance, wallet solana.PublicKey, mint string) uint64 {
+	for _, b := range balances {
+		if b.Owner == nil || !b.Owner.Equals(wallet) || b.Mint.String() != mint || b.UiTokenAmount == nil {
+			continue
 		}
+		amount, err := strconv.ParseUint(b.UiTokenAmount.Amount, 10, 64)
+		if err != nil {
+			continue
+		}
+		return amount
 	}
+	return 0
 }
 
 func (tb *TradingBot) getSOLBalance() (float64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Use the wallet address from config, not derived address
-	walletPubkey := solana.MustPublicKeyFromBase58(tb.config.WalletAddress)
+	walletPubkey := tb.wallet.PublicKey()
 	balance, err := tb.rpcClient.GetBalance(ctx, walletPubkey, rpc.CommitmentFinalized)
 	if err != nil {
+		metrics.RPCErrorsTotal.WithLabelValues(tb.config.RpcURL, "getBalance").Inc()
 		return 0, fmt.Errorf("failed to get SOL balance: %w", err)
 	}
 
@@ -419,8 +650,7 @@ func (tb *TradingBot) getUSDCBalance() (float64, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Use the wallet address from config, not derived address
-	walletPubkey := solana.MustPublicKeyFromBase58(tb.config.WalletAddress)
+	walletPubkey := tb.wallet.PublicKey()
 
 	// Get token accounts for USDC
 	usdcMint := solana.MustPublicKeyFromBase58(USDC_MINT)
@@ -430,6 +660,7 @@ func (tb *TradingBot) getUSDCBalance() (float64, error) {
 		Commitment: rpc.CommitmentFinalized,
 	})
 	if err != nil {
+		metrics.RPCErrorsTotal.WithLabelValues(tb.config.RpcURL, "getTokenAccountsByOwner").Inc()
 		return 0, fmt.Errorf("failed to get USDC token accounts: %w", err)
 	}
 
@@ -441,6 +672,7 @@ func (tb *TradingBot) getUSDCBalance() (float64, error) {
 	tokenAccount := tokenAccounts.Value[0]
 	balance, err := tb.rpcClient.GetTokenAccountBalance(ctx, tokenAccount.Pubkey, rpc.CommitmentFinalized)
 	if err != nil {
+		metrics.RPCErrorsTotal.WithLabelValues(tb.config.RpcURL, "getTokenAccountBalance").Inc()
 		return 0, fmt.Errorf("failed to get USDC balance: %w", err)
 	}
 
@@ -476,73 +708,203 @@ func (tb *TradingBot) getCurrentBalanceUSD(solPrice float64) (float64, string, e
 	}
 }
 
-func (tb *TradingBot) run() {
-	log.Printf("Starting Solana Trading Bot")
-	log.Printf("Initial balance: $%.2f in %s", tb.balance, tb.currentAsset)
-	log.Printf("Wallet address: %s", tb.wallet.PublicKey().String())
-	log.Printf("Price check interval: %ds", tb.config.PriceCheckIntervalSeconds)
-	log.Printf("Swap thresholds: %.1f%% - %.1f%%", tb.config.SwapThresholdMinPercent, tb.config.SwapThresholdMaxPercent)
-	log.Printf("Max swaps per day: %d", tb.config.MaxSwapsPerDay)
-	log.Printf("Simulate mode: %t", tb.config.SimulateMode)
+func (tb *TradingBot) run(ctx context.Context) {
+	slog.Info("starting Solana trading bot",
+		"initial_balance_usd", tb.balance, "asset", tb.currentAsset,
+		"wallet_address", tb.wallet.PublicKey().String(),
+		"price_check_interval_seconds", tb.config.PriceCheckIntervalSeconds,
+		"swap_threshold_min_percent", tb.config.SwapThresholdMinPercent,
+		"swap_threshold_max_percent", tb.config.SwapThresholdMaxPercent,
+		"max_swaps_per_day", tb.config.MaxSwapsPerDay,
+		"simulate_mode", tb.config.SimulateMode)
+
+	if tb.metricsServer != nil {
+		slog.Info("starting metrics server", "listen_addr", tb.config.MetricsListenAddr)
+		tb.metricsServer.Start(ctx)
+	}
 
 	ticker := time.NewTicker(time.Duration(tb.config.PriceCheckIntervalSeconds) * time.Second)
 	defer ticker.Stop()
 
 	for {
 		select {
+		case <-ctx.Done():
+			slog.Info("stopping trading bot", "reason", ctx.Err())
+			return
 		case <-ticker.C:
-			tb.resetDailySwapCount()
+			tb.tick(ctx)
+		}
+	}
+}
 
-			price, err := getSolanaPrice(tb.config.SlippageBps)
-			if err != nil {
-				log.Printf("Error getting SOL price: %v", err)
-				continue
-			}
+// tick runs a single price-check/swap-decision cycle. It is cancelled if ctx
+// is done before the tick's own deadline elapses.
+func (tb *TradingBot) tick(ctx context.Context) {
+	tickCtx, cancel := context.WithTimeout(ctx, time.Duration(tb.config.PriceCheckIntervalSeconds)*time.Second)
+	defer cancel()
 
-			// Get real balance from blockchain
-			realBalance, currentAsset, err := tb.getCurrentBalanceUSD(price)
-			if err != nil {
-				log.Printf("Error getting real balance: %v", err)
-				continue
-			}
+	tb.resetDailySwapCount()
 
-			// Update bot state with real balance
-			tb.balance = realBalance
-			tb.currentAsset = currentAsset
+	priceResult, err := tb.getSolanaPrice(tickCtx)
+	if err != nil {
+		slog.Error("failed to get SOL price", "error", err)
+		return
+	}
+	if tb.config.OracleMaxSpreadBps > 0 && priceResult.SpreadBps > tb.config.OracleMaxSpreadBps {
+		slog.Warn("skipping tick: oracle spread too wide",
+			"spread_bps", priceResult.SpreadBps, "sources", len(priceResult.Accepted),
+			"max_spread_bps", tb.config.OracleMaxSpreadBps)
+		return
+	}
+	price := priceResult.Price
 
-			log.Printf("Current SOL price: $%.2f | Holding: %s ($%.2f) | Last swap: $%.2f",
-				price, tb.currentAsset, tb.balance, tb.lastSwapPrice)
+	// Get real balance from blockchain
+	realBalance, currentAsset, err := tb.getCurrentBalanceUSD(price)
+	if err != nil {
+		slog.Error("failed to get real balance", "error", err)
+		return
+	}
 
-			shouldSwap, reason := tb.shouldSwap(price)
-			log.Printf("Swap decision: %s", reason)
+	// Update bot state with real balance
+	tb.balance = realBalance
+	tb.currentAsset = currentAsset
 
-			if shouldSwap {
-				err := tb.executeSwap(price)
-				if err != nil {
-					log.Printf("Swap failed: %v", err)
-				}
-			}
+	slog.Info("tick", "sol_price_usd", price, "asset", tb.currentAsset,
+		"balance_usd", tb.balance, "last_swap_price", tb.lastSwapPrice)
+
+	metrics.SOLPriceUSD.Set(price)
+	metrics.BalanceUSD.WithLabelValues(tb.currentAsset).Set(tb.balance)
+	if tb.metricsServer != nil {
+		tb.metricsServer.RecordTickSuccess(time.Now())
+	}
+
+	// First price observation: seed the reference price rather than judging
+	// a swap against zero.
+	if tb.lastSwapPrice == 0 {
+		tb.lastSwapPrice = price
+	}
+
+	tb.priceHistory.Add(price, time.Now())
+
+	decision := tb.strategy.Decide(tickCtx, tb.priceHistory, strategy.Position{
+		Asset:         tb.currentAsset,
+		LastSwapPrice: tb.lastSwapPrice,
+	})
+	slog.Info("swap decision", "kind", decision.Kind.String(), "reason", decision.Reason)
+
+	if err := tb.journal.RecordDecision(tickCtx, journal.DecisionEntry{
+		CorrelationID: fmt.Sprintf("tick-%d", time.Now().UnixNano()),
+		Time:          time.Now(),
+		Action:        decision.Kind.String(),
+		Reason:        decision.Reason,
+		Price:         price,
+	}); err != nil {
+		slog.Warn("failed to journal decision", "error", err)
+	}
+
+	if decision.Kind != strategy.Hold {
+		if err := tb.executeSwap(tickCtx, price, decision.SizeFraction); err != nil {
+			slog.Error("swap failed", "error", err)
 		}
 	}
 }
 
 func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
 	// Load configuration
 	config, err := loadConfig("config.json")
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "report" {
+		if err := runReport(config); err != nil {
+			slog.Error("failed to generate report", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 2 && os.Args[1] == "backtest" {
+		if err := runBacktest(config, os.Args[2]); err != nil {
+			slog.Error("failed to run backtest", "error", err)
+			os.Exit(1)
+		}
+		return
 	}
 
 	// Validate configuration
-	if config.PrivateKey == "YOUR_PRIVATE_KEY_HERE" || config.PrivateKey == "" {
-		log.Fatal("Please set your private key or seed phrase in config.json")
+	if config.PrivateKey == "YOUR_PRIVATE_KEY_HERE" {
+		slog.Error("please set your private key or seed phrase in config.json")
+		os.Exit(1)
+	}
+	if config.PrivateKey == "" && config.Mnemonic == "" {
+		slog.Error("please set either private_key or mnemonic in config.json")
+		os.Exit(1)
 	}
 
 	// Create and start trading bot
 	bot, err := NewTradingBot(config)
 	if err != nil {
-		log.Fatalf("Failed to create trading bot: %v", err)
+		slog.Error("failed to create trading bot", "error", err)
+		os.Exit(1)
+	}
+	defer bot.journal.Close()
+
+	bot.run(context.Background())
+}
+
+// runReport implements `bot report`: it opens the configured journal
+// (creating it if missing) and prints realized/unrealized PnL, win rate,
+// average slippage, and a per-day swap histogram.
+func runReport(config Config) error {
+	journalPath := config.JournalPath
+	if journalPath == "" {
+		journalPath = defaultJournalPath
+	}
+
+	journalDB, err := journal.Open(journalPath)
+	if err != nil {
+		return fmt.Errorf("failed to open trade journal: %w", err)
+	}
+	defer journalDB.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The report command has no live RPC connection to check the current
+	// balance, so it approximates the open position with the last confirmed
+	// swap's price and resulting asset.
+	currentPrice, _, err := journalDB.LastConfirmedSwapPrice(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load last swap price: %w", err)
+	}
+	currentAsset, ok, err := journalDB.LastConfirmedSwapAsset(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load last swap asset: %w", err)
+	}
+	if !ok {
+		currentAsset = "SOL"
 	}
 
-	bot.run()
-}
\ No newline at end of file
+	report, err := journalDB.GenerateReport(ctx, currentPrice, currentAsset)
+	if err != nil {
+		return fmt.Errorf("failed to generate report: %w", err)
+	}
+
+	fmt.Printf("Confirmed swaps:        %d\n", report.ConfirmedSwaps)
+	fmt.Printf("Failed swaps:           %d\n", report.FailedSwaps)
+	fmt.Printf("Completed round trips:  %d\n", report.CompletedRoundTrips)
+	fmt.Printf("Win rate:               %.1f%%\n", report.WinRate*100)
+	fmt.Printf("Avg slippage realized:  %.1f bps\n", report.AverageSlippageRealizedBps)
+	fmt.Printf("Realized PnL:           %.2f%%\n", report.RealizedPnLPercent)
+	fmt.Printf("Unrealized PnL:         %.2f%%\n", report.UnrealizedPnLPercent)
+	fmt.Println("Swaps per day:")
+	for day, count := range report.SwapsPerDay {
+		fmt.Printf("  %s: %d\n", day, count)
+	}
+
+	return nil
+}
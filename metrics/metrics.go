@@ -0,0 +1,57 @@
+// Package metrics holds the trading bot's Prometheus collectors and the
+// HTTP server that exposes them, so an operator can run the bot unattended
+// instead of tailing logs for swap outcomes and RPC health.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// SwapsTotal counts swap attempts by outcome ("success" or "failed")
+	// and direction ("buy_sol" or "sell_sol").
+	SwapsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bot_swaps_total",
+		Help: "Total number of swap attempts, by result and direction.",
+	}, []string{"result", "direction"})
+
+	// QuoteLatencySeconds observes how long Jupiter quote requests take.
+	QuoteLatencySeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_quote_latency_seconds",
+		Help:    "Latency of Jupiter quote requests, in seconds.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	// TxConfirmationSeconds observes time from submission to confirmation.
+	TxConfirmationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_tx_confirmation_seconds",
+		Help:    "Time from transaction submission to confirmation, in seconds.",
+		Buckets: prometheus.ExponentialBuckets(0.5, 2, 10),
+	})
+
+	// SlippageRealizedBps observes realized slippage for confirmed swaps.
+	SlippageRealizedBps = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "bot_slippage_realized_bps",
+		Help:    "Realized slippage in basis points for confirmed swaps.",
+		Buckets: prometheus.LinearBuckets(0, 10, 20),
+	})
+
+	// SOLPriceUSD is the last price the oracle reported.
+	SOLPriceUSD = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "bot_sol_price_usd",
+		Help: "Last observed SOL/USD price from the price oracle.",
+	})
+
+	// BalanceUSD is the current balance in USD, by held asset.
+	BalanceUSD = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bot_balance_usd",
+		Help: "Current balance in USD, by held asset.",
+	}, []string{"asset"})
+
+	// RPCErrorsTotal counts Solana RPC errors by endpoint and method.
+	RPCErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "bot_rpc_errors_total",
+		Help: "Total Solana RPC errors, by endpoint and method.",
+	}, []string{"endpoint", "method"})
+)
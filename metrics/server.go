@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Server exposes /metrics in Prometheus text format and /healthz, which
+// reports unhealthy once the last successful price tick is older than
+// MaxTickAge.
+type Server struct {
+	httpServer *http.Server
+	maxTickAge time.Duration
+
+	mu         sync.Mutex
+	lastTickAt time.Time
+}
+
+// NewServer builds a metrics server listening on addr. maxTickAge is how
+// stale the last successful tick may be before /healthz reports unhealthy.
+func NewServer(addr string, maxTickAge time.Duration) *Server {
+	s := &Server{maxTickAge: maxTickAge}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", s.handleHealthz)
+
+	s.httpServer = &http.Server{Addr: addr, Handler: mux}
+	return s
+}
+
+// RecordTickSuccess marks that a price tick completed successfully at t,
+// resetting the /healthz staleness clock.
+func (s *Server) RecordTickSuccess(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastTickAt = t
+}
+
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	lastTickAt := s.lastTickAt
+	s.mu.Unlock()
+
+	if lastTickAt.IsZero() {
+		// Still starting up; don't fail health checks before the first tick.
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintln(w, "ok (no ticks yet)")
+		return
+	}
+
+	age := time.Since(lastTickAt)
+	if age > s.maxTickAge {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintf(w, "unhealthy: last successful tick was %s ago (max %s)\n", age.Round(time.Second), s.maxTickAge)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "ok: last successful tick %s ago\n", age.Round(time.Second))
+}
+
+// Start begins serving /metrics and /healthz in the background and shuts
+// down once ctx is cancelled.
+func (s *Server) Start(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := s.httpServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("metrics server shutdown failed", "error", err)
+		}
+	}()
+
+	go func() {
+		if err := s.httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			slog.Error("metrics server stopped unexpectedly", "error", err)
+		}
+	}()
+}
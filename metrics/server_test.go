@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServer_HandleHealthz_NoTicksYetReportsOK(t *testing.T) {
+	s := NewServer(":0", time.Minute)
+
+	w := httptest.NewRecorder()
+	s.handleHealthz(w, httptest.NewRequest("GET", "/healthz", nil))
+
+	if w.Code != 200 {
+		t.Errorf("status = %d, want 200 before the first tick", w.Code)
+	}
+}
+
+func TestServer_HandleHealthz_FreshTickReportsOK(t *testing.T) {
+	s := NewServer(":0", time.Minute)
+	s.RecordTickSuccess(time.Now())
+
+	w := httptest.NewRecorder()
+	s.handleHealthz(w, httptest.NewRequest("GET", "/healthz", nil))
+
+	if w.Code != 200 {
+		t.Errorf("status = %d, want 200 for a fresh tick", w.Code)
+	}
+}
+
+func TestServer_HandleHealthz_StaleTickReportsUnavailable(t *testing.T) {
+	s := NewServer(":0", time.Minute)
+	s.RecordTickSuccess(time.Now().Add(-2 * time.Minute))
+
+	w := httptest.NewRecorder()
+	s.handleHealthz(w, httptest.NewRequest("GET", "/healthz", nil))
+
+	if w.Code != 503 {
+		t.Errorf("status = %d, want 503 for a tick older than maxTickAge", w.Code)
+	}
+}
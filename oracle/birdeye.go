@@ -0,0 +1,75 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultBirdeyeBaseURL is Birdeye's public API.
+const DefaultBirdeyeBaseURL = "https://public-api.birdeye.so"
+
+// BirdeyeOracle prices mints via the Birdeye API. An API key is required for
+// anything beyond a very small free quota.
+type BirdeyeOracle struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewBirdeyeOracle builds a Birdeye oracle. baseURL defaults to
+// DefaultBirdeyeBaseURL when empty.
+func NewBirdeyeOracle(baseURL, apiKey string) *BirdeyeOracle {
+	if baseURL == "" {
+		baseURL = DefaultBirdeyeBaseURL
+	}
+	return &BirdeyeOracle{
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type birdeyePriceResponse struct {
+	Data struct {
+		Value      float64 `json:"value"`
+		UpdateUnix int64   `json:"updateUnixTime"`
+	} `json:"data"`
+	Success bool `json:"success"`
+}
+
+func (o *BirdeyeOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/defi/price?address="+mint, nil)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: failed to build request: %w", err)
+	}
+	req.Header.Set("X-API-KEY", o.apiKey)
+	req.Header.Set("x-chain", "solana")
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var parsed birdeyePriceResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: failed to parse response: %w", err)
+	}
+	if !parsed.Success {
+		return 0, time.Time{}, fmt.Errorf("birdeye oracle: request unsuccessful for mint %s", mint)
+	}
+
+	return parsed.Data.Value, time.Unix(parsed.Data.UpdateUnix, 0), nil
+}
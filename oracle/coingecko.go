@@ -0,0 +1,88 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DefaultCoinGeckoBaseURL is CoinGecko's public API.
+const DefaultCoinGeckoBaseURL = "https://api.coingecko.com/api/v3"
+
+// CoinGeckoOracle prices mints via CoinGecko's simple price endpoint.
+// CoinGecko identifies assets by its own coin IDs rather than mint
+// addresses, so callers must supply a mint-to-coin-ID mapping.
+type CoinGeckoOracle struct {
+	baseURL    string
+	apiKey     string
+	coinIDs    map[string]string // mint -> CoinGecko coin ID
+	httpClient *http.Client
+}
+
+// NewCoinGeckoOracle builds a CoinGecko oracle. baseURL defaults to
+// DefaultCoinGeckoBaseURL when empty. apiKey may be empty to use the public,
+// more aggressively rate-limited tier.
+func NewCoinGeckoOracle(baseURL, apiKey string, coinIDs map[string]string) *CoinGeckoOracle {
+	if baseURL == "" {
+		baseURL = DefaultCoinGeckoBaseURL
+	}
+	return &CoinGeckoOracle{
+		baseURL:    baseURL,
+		apiKey:     apiKey,
+		coinIDs:    coinIDs,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (o *CoinGeckoOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	coinID, ok := o.coinIDs[mint]
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: no coin ID configured for mint %s", mint)
+	}
+
+	q := url.Values{}
+	q.Set("ids", coinID)
+	q.Set("vs_currencies", "usd")
+	q.Set("include_last_updated_at", "true")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/simple/price?"+q.Encode(), nil)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: failed to build request: %w", err)
+	}
+	if o.apiKey != "" {
+		req.Header.Set("x-cg-demo-api-key", o.apiKey)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var parsed map[string]struct {
+		USD           float64 `json:"usd"`
+		LastUpdatedAt int64   `json:"last_updated_at"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: failed to parse response: %w", err)
+	}
+
+	entry, ok := parsed[coinID]
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("coingecko oracle: no price returned for coin ID %s", coinID)
+	}
+
+	return entry.USD, time.Unix(entry.LastUpdatedAt, 0), nil
+}
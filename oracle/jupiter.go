@@ -0,0 +1,49 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nakul91/solana-trading-bot/jupiter"
+)
+
+// usdcMint mirrors main.go's USDC_MINT. The Jupiter
+// oracle only prices 9-decimal mints (SOL being the only one the bot
+// currently trades), so the amount is fixed at 1 whole unit.
+const (
+	usdcMint        = "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v"
+	lamportsPerUnit = 1_000_000_000
+)
+
+// JupiterOracle prices a mint via a Jupiter quote against USDC. It's the
+// least independent of the sources here (Jupiter is also used to execute
+// swaps), so MedianOracle should never rely on it alone.
+type JupiterOracle struct {
+	client *jupiter.Client
+}
+
+// NewJupiterOracle wraps an existing Jupiter client for price discovery.
+func NewJupiterOracle(client *jupiter.Client) *JupiterOracle {
+	return &JupiterOracle{client: client}
+}
+
+func (o *JupiterOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	quote, err := o.client.Quote(ctx, jupiter.QuoteParams{
+		InputMint:  mint,
+		OutputMint: usdcMint,
+		Amount:     lamportsPerUnit,
+	})
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("jupiter oracle: %w", err)
+	}
+
+	outAmount, err := strconv.ParseInt(quote.OutAmount, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("jupiter oracle: failed to parse outAmount: %w", err)
+	}
+
+	price := float64(outAmount) / 1_000_000 // USDC has 6 decimals
+	return price, time.Now(), nil
+}
@@ -0,0 +1,169 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// MedianResult is the outcome of querying every source in a MedianOracle.
+type MedianResult struct {
+	Price     float64
+	AsOf      time.Time
+	SpreadBps int      // spread of the accepted samples around the median, in bps; lower is tighter
+	Accepted  []Sample // samples used to compute Price
+	Rejected  []Sample // samples discarded as stale, outliers, or errored
+}
+
+// MedianOracle queries several PriceOracle sources in parallel and returns
+// the median of the samples that are both fresh and not outliers, so a
+// single stale or manipulated source can't skew the result.
+type MedianOracle struct {
+	sources         map[string]PriceOracle
+	maxStaleness    time.Duration
+	maxDeviationPct float64
+}
+
+// NewMedianOracle builds an aggregator over the given named sources.
+// maxStaleness discards any sample older than that duration; maxDeviationPct
+// discards any sample more than that percent away from the initial median.
+func NewMedianOracle(sources map[string]PriceOracle, maxStaleness time.Duration, maxDeviationPct float64) *MedianOracle {
+	return &MedianOracle{
+		sources:         sources,
+		maxStaleness:    maxStaleness,
+		maxDeviationPct: maxDeviationPct,
+	}
+}
+
+// Price implements PriceOracle by delegating to Median, discarding the
+// spread. Prefer calling Median directly so callers can act on a wide
+// spread instead of trading on it blindly.
+func (m *MedianOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	result, err := m.Median(ctx, mint)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return result.Price, result.AsOf, nil
+}
+
+// Median queries every configured source in parallel and returns the median
+// of the samples that survive staleness and outlier filtering.
+func (m *MedianOracle) Median(ctx context.Context, mint string) (*MedianResult, error) {
+	samples := m.collectSamples(ctx, mint)
+
+	var fresh, rejected []Sample
+	now := time.Now()
+	for _, s := range samples {
+		if s.Err != nil {
+			rejected = append(rejected, s)
+			continue
+		}
+		if m.maxStaleness > 0 && now.Sub(s.AsOf) > m.maxStaleness {
+			rejected = append(rejected, s)
+			continue
+		}
+		fresh = append(fresh, s)
+	}
+
+	if len(fresh) == 0 {
+		return nil, fmt.Errorf("oracle: no fresh price samples for mint %s (%d rejected)", mint, len(rejected))
+	}
+
+	pivotMedian := medianPrice(fresh)
+
+	var accepted []Sample
+	for _, s := range fresh {
+		deviationPct := math.Abs(s.Price-pivotMedian) / pivotMedian * 100
+		if m.maxDeviationPct > 0 && deviationPct > m.maxDeviationPct {
+			rejected = append(rejected, s)
+			continue
+		}
+		accepted = append(accepted, s)
+	}
+
+	if len(accepted) == 0 {
+		return nil, fmt.Errorf("oracle: all price samples for mint %s were outliers (%d rejected)", mint, len(rejected))
+	}
+
+	price := medianPrice(accepted)
+	asOf := latestSampleTime(accepted)
+
+	return &MedianResult{
+		Price:     price,
+		AsOf:      asOf,
+		SpreadBps: spreadBps(accepted, price),
+		Accepted:  accepted,
+		Rejected:  rejected,
+	}, nil
+}
+
+// collectSamples queries every source concurrently and waits for all of them
+// to finish or error.
+func (m *MedianOracle) collectSamples(ctx context.Context, mint string) []Sample {
+	type indexed struct {
+		i int
+		s Sample
+	}
+	results := make(chan indexed, len(m.sources))
+
+	names := make([]string, 0, len(m.sources))
+	for name := range m.sources {
+		names = append(names, name)
+	}
+
+	for i, name := range names {
+		go func(i int, name string, src PriceOracle) {
+			price, asOf, err := src.Price(ctx, mint)
+			results <- indexed{i, Sample{Source: name, Price: price, AsOf: asOf, Err: err}}
+		}(i, name, m.sources[name])
+	}
+
+	samples := make([]Sample, len(names))
+	for range names {
+		r := <-results
+		samples[r.i] = r.s
+	}
+	return samples
+}
+
+func medianPrice(samples []Sample) float64 {
+	prices := make([]float64, len(samples))
+	for i, s := range samples {
+		prices[i] = s.Price
+	}
+	sort.Float64s(prices)
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 0 {
+		return (prices[mid-1] + prices[mid]) / 2
+	}
+	return prices[mid]
+}
+
+func latestSampleTime(samples []Sample) time.Time {
+	latest := samples[0].AsOf
+	for _, s := range samples[1:] {
+		if s.AsOf.After(latest) {
+			latest = s.AsOf
+		}
+	}
+	return latest
+}
+
+// spreadBps reports how far the widest accepted sample strays from the
+// median, in basis points. A single-sample result has zero spread.
+func spreadBps(samples []Sample, median float64) int {
+	if median == 0 {
+		return 0
+	}
+	maxDeviation := 0.0
+	for _, s := range samples {
+		deviation := math.Abs(s.Price-median) / median
+		if deviation > maxDeviation {
+			maxDeviation = deviation
+		}
+	}
+	return int(maxDeviation * 10000)
+}
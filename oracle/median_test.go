@@ -0,0 +1,149 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeOracle is a PriceOracle that always returns a fixed price/time/error,
+// for deterministic MedianOracle tests.
+type fakeOracle struct {
+	price float64
+	asOf  time.Time
+	err   error
+}
+
+func (f fakeOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	return f.price, f.asOf, f.err
+}
+
+func TestMedianOracle_Median_AcceptsAgreeingSources(t *testing.T) {
+	now := time.Now()
+	sources := map[string]PriceOracle{
+		"a": fakeOracle{price: 100, asOf: now},
+		"b": fakeOracle{price: 101, asOf: now},
+		"c": fakeOracle{price: 99, asOf: now},
+	}
+	m := NewMedianOracle(sources, time.Minute, 5)
+
+	result, err := m.Median(context.Background(), "mint")
+	if err != nil {
+		t.Fatalf("Median returned error: %v", err)
+	}
+	if result.Price != 100 {
+		t.Errorf("Price = %v, want 100", result.Price)
+	}
+	if len(result.Accepted) != 3 {
+		t.Errorf("len(Accepted) = %d, want 3", len(result.Accepted))
+	}
+	if len(result.Rejected) != 0 {
+		t.Errorf("len(Rejected) = %d, want 0", len(result.Rejected))
+	}
+}
+
+func TestMedianOracle_Median_RejectsStaleSamples(t *testing.T) {
+	now := time.Now()
+	sources := map[string]PriceOracle{
+		"fresh": fakeOracle{price: 100, asOf: now},
+		"stale": fakeOracle{price: 200, asOf: now.Add(-time.Hour)},
+	}
+	m := NewMedianOracle(sources, time.Minute, 0)
+
+	result, err := m.Median(context.Background(), "mint")
+	if err != nil {
+		t.Fatalf("Median returned error: %v", err)
+	}
+	if result.Price != 100 {
+		t.Errorf("Price = %v, want 100 (stale sample should be excluded)", result.Price)
+	}
+	if len(result.Rejected) != 1 {
+		t.Errorf("len(Rejected) = %d, want 1", len(result.Rejected))
+	}
+}
+
+func TestMedianOracle_Median_RejectsOutliers(t *testing.T) {
+	now := time.Now()
+	sources := map[string]PriceOracle{
+		"a":           fakeOracle{price: 100, asOf: now},
+		"b":           fakeOracle{price: 101, asOf: now},
+		"c":           fakeOracle{price: 102, asOf: now},
+		"manipulated": fakeOracle{price: 1000, asOf: now},
+	}
+	m := NewMedianOracle(sources, time.Minute, 5)
+
+	result, err := m.Median(context.Background(), "mint")
+	if err != nil {
+		t.Fatalf("Median returned error: %v", err)
+	}
+	for _, s := range result.Accepted {
+		if s.Source == "manipulated" {
+			t.Errorf("outlier source %q was accepted", s.Source)
+		}
+	}
+	if len(result.Rejected) != 1 {
+		t.Errorf("len(Rejected) = %d, want 1", len(result.Rejected))
+	}
+}
+
+func TestMedianOracle_Median_ErrorsWhenNoFreshSamples(t *testing.T) {
+	sources := map[string]PriceOracle{
+		"a": fakeOracle{err: errors.New("source unavailable")},
+		"b": fakeOracle{asOf: time.Now().Add(-time.Hour)},
+	}
+	m := NewMedianOracle(sources, time.Minute, 5)
+
+	if _, err := m.Median(context.Background(), "mint"); err == nil {
+		t.Fatal("expected an error when no sources have fresh samples, got nil")
+	}
+}
+
+func TestMedianOracle_Median_ErrorsWhenAllOutliers(t *testing.T) {
+	now := time.Now()
+	sources := map[string]PriceOracle{
+		"a": fakeOracle{price: 100, asOf: now},
+		"b": fakeOracle{price: 1000, asOf: now},
+	}
+	m := NewMedianOracle(sources, time.Minute, 1)
+
+	if _, err := m.Median(context.Background(), "mint"); err == nil {
+		t.Fatal("expected an error when every sample is an outlier relative to the pivot median, got nil")
+	}
+}
+
+func TestSpreadBps(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []Sample
+		median  float64
+		want    int
+	}{
+		{
+			name:    "single sample has zero spread",
+			samples: []Sample{{Price: 100}},
+			median:  100,
+			want:    0,
+		},
+		{
+			name:    "widest deviation determines spread",
+			samples: []Sample{{Price: 100}, {Price: 101}, {Price: 98}},
+			median:  100,
+			want:    200, // (100-98)/100 = 2% = 200bps, wider than the 1% deviation
+		},
+		{
+			name:    "zero median avoids divide by zero",
+			samples: []Sample{{Price: 0}},
+			median:  0,
+			want:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := spreadBps(tt.samples, tt.median); got != tt.want {
+				t.Errorf("spreadBps() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
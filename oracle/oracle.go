@@ -0,0 +1,25 @@
+// Package oracle provides price discovery for Solana tokens from multiple
+// independent sources, so the bot isn't dependent on (or manipulable via) a
+// single quote provider.
+package oracle
+
+import (
+	"context"
+	"time"
+)
+
+// PriceOracle returns the current USD price of a mint. The returned time is
+// when the source considers the price to have been observed, which may
+// predate the call (e.g. a cached oracle update).
+type PriceOracle interface {
+	Price(ctx context.Context, mint string) (float64, time.Time, error)
+}
+
+// Sample is one source's observation, kept around on MedianResult so callers
+// and logs can see what was discarded and why.
+type Sample struct {
+	Source string
+	Price  float64
+	AsOf   time.Time
+	Err    error
+}
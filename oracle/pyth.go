@@ -0,0 +1,112 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultPythBaseURL is Pyth's public Hermes pull-oracle endpoint.
+const DefaultPythBaseURL = "https://hermes.pyth.network"
+
+// PythSOLUSDFeedID is Pyth's SOL/USD price feed ID.
+// https://pyth.network/developers/price-feed-ids
+const PythSOLUSDFeedID = "ef0d8b6fda2ceba41da15d4095d1da392a0d2f8ed0c6c7bc0f4cfac8c280b56d"
+
+// PythOracle prices mints via Pyth's Hermes pull-oracle API. Pyth identifies
+// prices by opaque feed IDs rather than mint addresses, so callers must
+// supply a mint-to-feed-ID mapping.
+type PythOracle struct {
+	baseURL    string
+	feedIDs    map[string]string // mint -> Pyth price feed ID
+	httpClient *http.Client
+}
+
+// NewPythOracle builds a Pyth oracle. baseURL defaults to DefaultPythBaseURL
+// when empty.
+func NewPythOracle(baseURL string, feedIDs map[string]string) *PythOracle {
+	if baseURL == "" {
+		baseURL = DefaultPythBaseURL
+	}
+	return &PythOracle{
+		baseURL:    baseURL,
+		feedIDs:    feedIDs,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type pythUpdateResponse struct {
+	Parsed []struct {
+		Price struct {
+			Price       string `json:"price"`
+			Expo        int    `json:"expo"`
+			PublishTime int64  `json:"publish_time"`
+		} `json:"price"`
+	} `json:"parsed"`
+}
+
+func (o *PythOracle) Price(ctx context.Context, mint string) (float64, time.Time, error) {
+	feedID, ok := o.feedIDs[mint]
+	if !ok {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: no feed ID configured for mint %s", mint)
+	}
+
+	q := url.Values{}
+	q.Add("ids[]", feedID)
+	q.Set("parsed", "true")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.baseURL+"/v2/updates/price/latest?"+q.Encode(), nil)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: failed to build request: %w", err)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var update pythUpdateResponse
+	if err := json.Unmarshal(body, &update); err != nil {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: failed to parse response: %w", err)
+	}
+	if len(update.Parsed) == 0 {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: no price returned for feed %s", feedID)
+	}
+
+	p := update.Parsed[0].Price
+	rawPrice, err := strconv.ParseInt(p.Price, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, fmt.Errorf("pyth oracle: failed to parse price: %w", err)
+	}
+
+	price := float64(rawPrice) * pow10(p.Expo)
+	return price, time.Unix(p.PublishTime, 0), nil
+}
+
+func pow10(expo int) float64 {
+	result := 1.0
+	if expo < 0 {
+		for i := 0; i < -expo; i++ {
+			result /= 10
+		}
+		return result
+	}
+	for i := 0; i < expo; i++ {
+		result *= 10
+	}
+	return result
+}
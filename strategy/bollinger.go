@@ -0,0 +1,39 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+)
+
+// BollingerBreakoutStrategy tracks an N-period SMA with bands at
+// K standard deviations. A close above the upper band is a bullish
+// breakout (buy SOL); a close below the lower band is a bearish breakout
+// (sell SOL).
+type BollingerBreakoutStrategy struct {
+	Period int
+	K      float64
+}
+
+func (s BollingerBreakoutStrategy) Decide(ctx context.Context, history *PriceHistory, position Position) Action {
+	prices := history.Prices()
+	if len(prices) < s.Period {
+		return hold(fmt.Sprintf("need %d prices for Bollinger(%d), have %d", s.Period, s.Period, len(prices)))
+	}
+
+	mean := sma(prices, s.Period)
+	dev := stddev(prices, s.Period, mean)
+	upper := mean + s.K*dev
+	lower := mean - s.K*dev
+	price := prices[len(prices)-1]
+
+	if price > upper && position.Asset == "USDC" {
+		return Action{Kind: BuySOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"price %.4f broke above upper Bollinger band %.4f (SMA %.4f +%.1fsd)", price, upper, mean, s.K)}
+	}
+	if price < lower && position.Asset == "SOL" {
+		return Action{Kind: SellSOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"price %.4f broke below lower Bollinger band %.4f (SMA %.4f -%.1fsd)", price, lower, mean, s.K)}
+	}
+
+	return hold(fmt.Sprintf("price %.4f within Bollinger bands [%.4f, %.4f]", price, lower, upper))
+}
@@ -0,0 +1,46 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+)
+
+// EMACrossoverStrategy swaps on a crossover between a fast and a slow
+// exponential moving average: fast crossing above slow is bullish (buy
+// SOL), fast crossing below slow is bearish (sell SOL).
+type EMACrossoverStrategy struct {
+	FastPeriod int
+	SlowPeriod int
+}
+
+func (s EMACrossoverStrategy) Decide(ctx context.Context, history *PriceHistory, position Position) Action {
+	prices := history.Prices()
+	// Need two slow-EMA points to detect a crossover.
+	if len(prices) < s.SlowPeriod+1 {
+		return hold(fmt.Sprintf("need %d prices for EMA(%d), have %d", s.SlowPeriod+1, s.SlowPeriod, len(prices)))
+	}
+
+	fast := ema(prices, s.FastPeriod)
+	slow := ema(prices, s.SlowPeriod)
+
+	// Align both series on the slow EMA's shorter length.
+	fast = fast[len(fast)-len(slow):]
+
+	n := len(slow)
+	prevFast, prevSlow := fast[n-2], slow[n-2]
+	curFast, curSlow := fast[n-1], slow[n-1]
+
+	crossedUp := prevFast <= prevSlow && curFast > curSlow
+	crossedDown := prevFast >= prevSlow && curFast < curSlow
+
+	if crossedUp && position.Asset == "USDC" {
+		return Action{Kind: BuySOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"EMA(%d) crossed above EMA(%d) (%.4f > %.4f)", s.FastPeriod, s.SlowPeriod, curFast, curSlow)}
+	}
+	if crossedDown && position.Asset == "SOL" {
+		return Action{Kind: SellSOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"EMA(%d) crossed below EMA(%d) (%.4f < %.4f)", s.FastPeriod, s.SlowPeriod, curFast, curSlow)}
+	}
+
+	return hold(fmt.Sprintf("no EMA crossover (fast %.4f, slow %.4f)", curFast, curSlow))
+}
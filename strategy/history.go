@@ -0,0 +1,65 @@
+package strategy
+
+import "time"
+
+// PriceHistory is a fixed-size ring buffer of recent prices. TradingBot
+// appends to it once per tick so strategies can compute moving averages,
+// RSI, and similar indicators without each maintaining its own window.
+type PriceHistory struct {
+	prices []float64
+	times  []time.Time
+	next   int
+	filled bool
+}
+
+// NewPriceHistory creates a PriceHistory that retains the most recent
+// capacity prices.
+func NewPriceHistory(capacity int) *PriceHistory {
+	return &PriceHistory{
+		prices: make([]float64, capacity),
+		times:  make([]time.Time, capacity),
+	}
+}
+
+// Add records a new price observation, evicting the oldest one once the
+// buffer is full.
+func (h *PriceHistory) Add(price float64, at time.Time) {
+	h.prices[h.next] = price
+	h.times[h.next] = at
+	h.next = (h.next + 1) % len(h.prices)
+	if h.next == 0 {
+		h.filled = true
+	}
+}
+
+// Len returns how many prices are currently held, up to the configured
+// capacity.
+func (h *PriceHistory) Len() int {
+	if h.filled {
+		return len(h.prices)
+	}
+	return h.next
+}
+
+// Prices returns a snapshot of the held prices, oldest first.
+func (h *PriceHistory) Prices() []float64 {
+	n := h.Len()
+	out := make([]float64, n)
+	if !h.filled {
+		copy(out, h.prices[:n])
+		return out
+	}
+	copy(out, h.prices[h.next:])
+	copy(out[len(h.prices)-h.next:], h.prices[:h.next])
+	return out
+}
+
+// Last returns the most recently added price, and false if empty.
+func (h *PriceHistory) Last() (float64, bool) {
+	n := h.Len()
+	if n == 0 {
+		return 0, false
+	}
+	idx := (h.next - 1 + len(h.prices)) % len(h.prices)
+	return h.prices[idx], true
+}
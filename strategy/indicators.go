@@ -0,0 +1,75 @@
+package strategy
+
+import "math"
+
+// ema computes the exponential moving average series for prices using the
+// given period, seeding it with a simple moving average of the first
+// period prices. The returned slice is shorter than prices by period-1
+// elements, aligned to prices[period-1:].
+func ema(prices []float64, period int) []float64 {
+	if len(prices) < period {
+		return nil
+	}
+
+	var seed float64
+	for _, p := range prices[:period] {
+		seed += p
+	}
+	seed /= float64(period)
+
+	out := make([]float64, len(prices)-period+1)
+	out[0] = seed
+
+	k := 2.0 / float64(period+1)
+	for i := period; i < len(prices); i++ {
+		out[i-period+1] = prices[i]*k + out[i-period]*(1-k)
+	}
+	return out
+}
+
+// sma computes the simple moving average of the last period prices.
+func sma(prices []float64, period int) float64 {
+	window := prices[len(prices)-period:]
+	var sum float64
+	for _, p := range window {
+		sum += p
+	}
+	return sum / float64(period)
+}
+
+// stddev computes the population standard deviation of the last period
+// prices around mean.
+func stddev(prices []float64, period int, mean float64) float64 {
+	window := prices[len(prices)-period:]
+	var sumSq float64
+	for _, p := range window {
+		d := p - mean
+		sumSq += d * d
+	}
+	return math.Sqrt(sumSq / float64(period))
+}
+
+// rsi computes the Wilder relative strength index over the last period+1
+// prices (period price changes).
+func rsi(prices []float64, period int) float64 {
+	window := prices[len(prices)-period-1:]
+
+	var gainSum, lossSum float64
+	for i := 1; i < len(window); i++ {
+		change := window[i] - window[i-1]
+		if change > 0 {
+			gainSum += change
+		} else {
+			lossSum += -change
+		}
+	}
+
+	avgGain := gainSum / float64(period)
+	avgLoss := lossSum / float64(period)
+
+	if avgLoss == 0 {
+		return 100
+	}
+	rs := avgGain / avgLoss
+	return 100 - (100 / (1 + rs))
+}
@@ -0,0 +1,84 @@
+package strategy
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestSMA(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5}
+
+	if got := sma(prices, 3); !approxEqual(got, 4) {
+		t.Errorf("sma(last 3 of %v) = %v, want 4 (avg of 3,4,5)", prices, got)
+	}
+	if got := sma(prices, 5); !approxEqual(got, 3) {
+		t.Errorf("sma(last 5 of %v) = %v, want 3", prices, got)
+	}
+}
+
+func TestStddev(t *testing.T) {
+	prices := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+	mean := sma(prices, len(prices))
+
+	got := stddev(prices, len(prices), mean)
+	want := 2.0 // classic population stddev example
+	if !approxEqual(got, want) {
+		t.Errorf("stddev(%v) = %v, want %v", prices, got, want)
+	}
+}
+
+func TestEMA_SeedsWithSMAAndAlignsToPricesTail(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5}
+	period := 3
+
+	got := ema(prices, period)
+
+	wantLen := len(prices) - period + 1
+	if len(got) != wantLen {
+		t.Fatalf("len(ema) = %d, want %d (aligned to prices[period-1:])", len(got), wantLen)
+	}
+
+	// First EMA value seeds with the SMA of the first `period` prices.
+	if !approxEqual(got[0], sma(prices[:period], period)) {
+		t.Errorf("ema[0] = %v, want seed SMA %v", got[0], sma(prices[:period], period))
+	}
+
+	// Subsequent values are seed-forward EMA, not a fresh SMA each step.
+	k := 2.0 / float64(period+1)
+	want1 := (prices[period]-got[0])*k + got[0]
+	if !approxEqual(got[1], want1) {
+		t.Errorf("ema[1] = %v, want %v", got[1], want1)
+	}
+}
+
+func TestRSI_AllGainsIsHundred(t *testing.T) {
+	prices := []float64{1, 2, 3, 4, 5, 6}
+
+	if got := rsi(prices, 5); !approxEqual(got, 100) {
+		t.Errorf("rsi(all gains) = %v, want 100 (avgLoss == 0)", got)
+	}
+}
+
+func TestRSI_AllLossesIsZero(t *testing.T) {
+	prices := []float64{6, 5, 4, 3, 2, 1}
+
+	if got := rsi(prices, 5); !approxEqual(got, 0) {
+		t.Errorf("rsi(all losses) = %v, want 0", got)
+	}
+}
+
+func TestRSI_UsesLastPeriodPlusOnePrices(t *testing.T) {
+	// A leading price movement outside the window must not affect the result.
+	withNoise := []float64{1000, 1, 2, 3, 2, 3, 4}
+	withoutNoise := []float64{1, 2, 3, 2, 3, 4}
+
+	got := rsi(withNoise, 5)
+	want := rsi(withoutNoise, 5)
+	if !approxEqual(got, want) {
+		t.Errorf("rsi(period+1 window) = %v, want %v (leading price outside window affected result)", got, want)
+	}
+}
@@ -0,0 +1,34 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+)
+
+// RSIMeanReversionStrategy buys SOL when RSI drops below BuyBelow (oversold)
+// and sells SOL when RSI rises above SellAbove (overbought).
+type RSIMeanReversionStrategy struct {
+	Period    int
+	BuyBelow  float64
+	SellAbove float64
+}
+
+func (s RSIMeanReversionStrategy) Decide(ctx context.Context, history *PriceHistory, position Position) Action {
+	prices := history.Prices()
+	if len(prices) < s.Period+1 {
+		return hold(fmt.Sprintf("need %d prices for RSI(%d), have %d", s.Period+1, s.Period, len(prices)))
+	}
+
+	value := rsi(prices, s.Period)
+
+	if value < s.BuyBelow && position.Asset == "USDC" {
+		return Action{Kind: BuySOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"RSI(%d) %.1f is oversold (< %.1f)", s.Period, value, s.BuyBelow)}
+	}
+	if value > s.SellAbove && position.Asset == "SOL" {
+		return Action{Kind: SellSOL, SizeFraction: 1, Reason: fmt.Sprintf(
+			"RSI(%d) %.1f is overbought (> %.1f)", s.Period, value, s.SellAbove)}
+	}
+
+	return hold(fmt.Sprintf("RSI(%d) %.1f is neutral", s.Period, value))
+}
@@ -0,0 +1,57 @@
+// Package strategy defines pluggable swap-decision logic for the trading
+// bot. TradingBot appends each tick's price to a rolling PriceHistory and
+// asks the configured Strategy what to do with the current Position,
+// replacing the single hard-coded threshold rule main.go used to have.
+package strategy
+
+import "context"
+
+// Kind is what a Strategy wants TradingBot to do this tick.
+type Kind int
+
+const (
+	Hold Kind = iota
+	BuySOL
+	SellSOL
+)
+
+// String renders a Kind the same way journal decision entries already
+// record actions ("hold", "buy_sol", "sell_sol").
+func (k Kind) String() string {
+	switch k {
+	case BuySOL:
+		return "buy_sol"
+	case SellSOL:
+		return "sell_sol"
+	default:
+		return "hold"
+	}
+}
+
+// Action is a Strategy's recommendation for the current tick.
+type Action struct {
+	Kind Kind
+	// SizeFraction is the fraction (0, 1] of the current asset's balance to
+	// swap. Strategies that don't size positions should use 1.
+	SizeFraction float64
+	Reason       string
+}
+
+// Position describes what TradingBot currently holds.
+type Position struct {
+	Asset         string // "SOL" or "USDC"
+	LastSwapPrice float64
+}
+
+// Strategy decides whether to swap given the recent price history and the
+// bot's current position. Implementations must be safe to call repeatedly
+// with a growing history; they should not retain history or position
+// between calls.
+type Strategy interface {
+	Decide(ctx context.Context, history *PriceHistory, position Position) Action
+}
+
+// hold is a convenience constructor for a no-op decision.
+func hold(reason string) Action {
+	return Action{Kind: Hold, Reason: reason}
+}
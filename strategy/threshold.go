@@ -0,0 +1,46 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+)
+
+// ThresholdStrategy is the bot's original rule: swap whenever price has
+// moved MinPercent away from the last swap price, in the direction that
+// favors the asset currently held.
+type ThresholdStrategy struct {
+	MinPercent float64
+}
+
+func (s ThresholdStrategy) Decide(ctx context.Context, history *PriceHistory, position Position) Action {
+	price, ok := history.Last()
+	if !ok {
+		return hold("no price history yet")
+	}
+
+	if position.LastSwapPrice == 0 {
+		return hold("no reference price set yet")
+	}
+
+	changePercent := ((price - position.LastSwapPrice) / position.LastSwapPrice) * 100
+
+	if position.Asset == "SOL" && changePercent >= s.MinPercent {
+		return Action{
+			Kind:         SellSOL,
+			SizeFraction: 1,
+			Reason: fmt.Sprintf("SOL price increased by %.2f%% (%.2f -> %.2f), swapping to USDC",
+				changePercent, position.LastSwapPrice, price),
+		}
+	}
+
+	if position.Asset == "USDC" && changePercent <= -s.MinPercent {
+		return Action{
+			Kind:         BuySOL,
+			SizeFraction: 1,
+			Reason: fmt.Sprintf("SOL price decreased by %.2f%% (%.2f -> %.2f), swapping to SOL",
+				changePercent, position.LastSwapPrice, price),
+		}
+	}
+
+	return hold(fmt.Sprintf("price change %.2f%% doesn't meet swap criteria", changePercent))
+}
@@ -0,0 +1,55 @@
+package strategy
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestThresholdStrategy_Decide(t *testing.T) {
+	s := ThresholdStrategy{MinPercent: 5}
+	history := NewPriceHistory(10)
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		price    float64
+		position Position
+		want     Kind
+	}{
+		{
+			name:     "SOL price up past threshold sells",
+			price:    105.01,
+			position: Position{Asset: "SOL", LastSwapPrice: 100},
+			want:     SellSOL,
+		},
+		{
+			name:     "SOL price up below threshold holds",
+			price:    104,
+			position: Position{Asset: "SOL", LastSwapPrice: 100},
+			want:     Hold,
+		},
+		{
+			name:     "USDC price down past threshold buys",
+			price:    94.99,
+			position: Position{Asset: "USDC", LastSwapPrice: 100},
+			want:     BuySOL,
+		},
+		{
+			name:     "no reference price holds",
+			price:    100,
+			position: Position{Asset: "USDC", LastSwapPrice: 0},
+			want:     Hold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history.Add(tt.price, now)
+			got := s.Decide(context.Background(), history, tt.position)
+			if got.Kind != tt.want {
+				t.Errorf("Decide() = %v, want %v (reason: %s)", got.Kind, tt.want, got.Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package submit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+func TestSubmitBundle_SetsBase64EncodingOption(t *testing.T) {
+	var gotParams []json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode bundle request: %v", err)
+		}
+		gotParams = req.Params
+		json.NewEncoder(w).Encode(map[string]string{"result": "bundle-id"})
+	}))
+	defer server.Close()
+
+	cfg := JitoConfig{BundleURL: server.URL}
+	bundleID, err := SubmitBundle(context.Background(), cfg, &solana.Transaction{})
+	if err != nil {
+		t.Fatalf("SubmitBundle returned error: %v", err)
+	}
+	if bundleID != "bundle-id" {
+		t.Errorf("bundleID = %q, want %q", bundleID, "bundle-id")
+	}
+
+	if len(gotParams) != 2 {
+		t.Fatalf("len(params) = %d, want 2 (transactions, encoding options)", len(gotParams))
+	}
+	var opts struct {
+		Encoding string `json:"encoding"`
+	}
+	if err := json.Unmarshal(gotParams[1], &opts); err != nil {
+		t.Fatalf("failed to decode encoding options: %v", err)
+	}
+	if opts.Encoding != "base64" {
+		t.Errorf("encoding = %q, want %q", opts.Encoding, "base64")
+	}
+}
@@ -0,0 +1,180 @@
+// Package submit provides durable transaction submission: racing a signed
+// transaction across multiple RPC endpoints, and resubmitting it against a
+// fresh blockhash until it confirms or its original blockhash expires.
+// executeRealSwap previously sent to one RPC and gave up on the first
+// timeout; during congestion that's the main reason swaps don't land.
+package submit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/nakul91/solana-trading-bot/metrics"
+)
+
+// resubmitInterval is how often an unconfirmed transaction is resent while
+// waiting for it to land, matching typical Solana slot time.
+const resubmitInterval = 2 * time.Second
+
+// Signer re-signs a transaction against a fresh blockhash. It mirrors the
+// signing closure TradingBot already builds around its wallet.
+type Signer func(tx *solana.Transaction) error
+
+// TxFailedError reports that a submitted transaction was included on-chain
+// but failed to execute, per its signature status. This is terminal: a
+// failed transaction will never confirm on resubmission, so callers should
+// stop retrying rather than waiting for lastValidBlockHeight to pass.
+type TxFailedError struct {
+	Signature solana.Signature
+	Err       interface{}
+}
+
+func (e *TxFailedError) Error() string {
+	return fmt.Sprintf("transaction %s failed on-chain: %v", e.Signature, e.Err)
+}
+
+// Submitter races a signed transaction across multiple RPC endpoints and
+// keeps resubmitting it against fresh blockhashes until it confirms or the
+// original blockhash's last valid block height is exceeded.
+type Submitter struct {
+	endpoints  []string
+	rpcClients []*rpc.Client
+}
+
+// NewSubmitter builds a Submitter that sends to every given RPC endpoint.
+func NewSubmitter(endpoints []string) *Submitter {
+	clients := make([]*rpc.Client, len(endpoints))
+	for i, endpoint := range endpoints {
+		clients[i] = rpc.New(endpoint)
+	}
+	return &Submitter{endpoints: endpoints, rpcClients: clients}
+}
+
+// race sends tx to every configured RPC concurrently and returns the first
+// signature any of them accepts. A transaction that's already been accepted
+// by one RPC will usually be accepted (not re-executed) by the others too,
+// since Solana dedupes by signature.
+func (s *Submitter) race(ctx context.Context, tx *solana.Transaction) (solana.Signature, error) {
+	type result struct {
+		sig      solana.Signature
+		err      error
+		endpoint string
+	}
+	results := make(chan result, len(s.rpcClients))
+
+	for i, client := range s.rpcClients {
+		go func(client *rpc.Client, endpoint string) {
+			sig, err := client.SendTransactionWithOpts(ctx, tx, rpc.TransactionOpts{
+				SkipPreflight: true,
+			})
+			results <- result{sig, err, endpoint}
+		}(client, s.endpoints[i])
+	}
+
+	var lastErr error
+	for range s.rpcClients {
+		r := <-results
+		if r.err == nil {
+			return r.sig, nil
+		}
+		metrics.RPCErrorsTotal.WithLabelValues(r.endpoint, "sendTransaction").Inc()
+		lastErr = r.err
+	}
+	return solana.Signature{}, fmt.Errorf("all %d RPC endpoints rejected the transaction: %w", len(s.rpcClients), lastErr)
+}
+
+// SubmitAndConfirm sends tx, racing across every configured RPC, and keeps
+// resubmitting against a fresh blockhash (via sign) every resubmitInterval
+// until a signature confirms or the original lastValidBlockHeight is
+// exceeded. Every signature submitted so far is checked for confirmation,
+// since a resubmission after a blockhash refresh produces a new one; the
+// one that confirms is returned.
+func (s *Submitter) SubmitAndConfirm(ctx context.Context, tx *solana.Transaction, lastValidBlockHeight uint64, sign Signer) (solana.Signature, error) {
+	seen := map[solana.Signature]bool{}
+
+	sig, err := s.race(ctx, tx)
+	if err != nil {
+		return solana.Signature{}, err
+	}
+	seen[sig] = true
+
+	ticker := time.NewTicker(resubmitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return solana.Signature{}, fmt.Errorf("transaction submission cancelled: %w", ctx.Err())
+		case <-ticker.C:
+			confirmed, currentHeight, err := s.anyConfirmed(ctx, seen)
+			if err != nil {
+				var failed *TxFailedError
+				if errors.As(err, &failed) {
+					return solana.Signature{}, err
+				}
+				continue
+			}
+			if confirmed != (solana.Signature{}) {
+				return confirmed, nil
+			}
+
+			if currentHeight > lastValidBlockHeight {
+				return solana.Signature{}, fmt.Errorf("transaction expired before confirming (current block: %d, last valid: %d, %d signatures tried)",
+					currentHeight, lastValidBlockHeight, len(seen))
+			}
+
+			if err := sign(tx); err != nil {
+				return solana.Signature{}, fmt.Errorf("failed to re-sign with fresh blockhash: %w", err)
+			}
+			newSig, err := s.race(ctx, tx)
+			if err != nil {
+				continue
+			}
+			seen[newSig] = true
+		}
+	}
+}
+
+// anyConfirmed checks every signature submitted so far against the first
+// RPC client and reports the current block height alongside whichever
+// signature (if any) has confirmed. It returns a *TxFailedError, which is
+// terminal, the moment any signature comes back with an on-chain error.
+func (s *Submitter) anyConfirmed(ctx context.Context, signatures map[solana.Signature]bool) (solana.Signature, uint64, error) {
+	client := s.rpcClients[0]
+	endpoint := s.endpoints[0]
+
+	sigs := make([]solana.Signature, 0, len(signatures))
+	for sig := range signatures {
+		sigs = append(sigs, sig)
+	}
+
+	statuses, err := client.GetSignatureStatuses(ctx, true, sigs...)
+	if err != nil {
+		metrics.RPCErrorsTotal.WithLabelValues(endpoint, "getSignatureStatuses").Inc()
+		return solana.Signature{}, 0, fmt.Errorf("failed to get signature statuses: %w", err)
+	}
+
+	for i, status := range statuses.Value {
+		if status == nil {
+			continue
+		}
+		if status.Err != nil {
+			return solana.Signature{}, 0, &TxFailedError{Signature: sigs[i], Err: status.Err}
+		}
+		if status.ConfirmationStatus != "" {
+			return sigs[i], 0, nil
+		}
+	}
+
+	height, err := client.GetBlockHeight(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		metrics.RPCErrorsTotal.WithLabelValues(endpoint, "getBlockHeight").Inc()
+		return solana.Signature{}, 0, fmt.Errorf("failed to get block height: %w", err)
+	}
+	return solana.Signature{}, height, nil
+}
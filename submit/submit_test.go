@@ -0,0 +1,142 @@
+package submit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// jsonRPCRequest is the subset of a JSON-RPC 2.0 request this test cares
+// about: enough to dispatch on method name.
+type jsonRPCRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+// newFakeRPCServer starts an httptest.Server that dispatches JSON-RPC
+// requests to handlers by method name, wrapping each handler's return value
+// in a standard {"jsonrpc","id","result"} envelope.
+func newFakeRPCServer(t *testing.T, handlers map[string]func() interface{}) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req jsonRPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode JSON-RPC request: %v", err)
+		}
+		handler, ok := handlers[req.Method]
+		if !ok {
+			t.Fatalf("unexpected JSON-RPC method %q", req.Method)
+		}
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      json.RawMessage(req.ID),
+			"result":  handler(),
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func signatureStatusResult(slot uint64, confirmationStatus string, txErr interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"slot":               slot,
+		"confirmations":      nil,
+		"err":                txErr,
+		"confirmationStatus": confirmationStatus,
+	}
+}
+
+func TestSubmitter_race_ReturnsFirstSuccess(t *testing.T) {
+	wantSig := solana.Signature{1, 2, 3}
+	ok := newFakeRPCServer(t, map[string]func() interface{}{
+		"sendTransaction": func() interface{} { return wantSig.String() },
+	})
+	rejecting := newFakeRPCServer(t, map[string]func() interface{}{
+		"sendTransaction": func() interface{} { return nil },
+	})
+
+	s := NewSubmitter([]string{ok.URL, rejecting.URL})
+	tx := &solana.Transaction{}
+	sig, err := s.race(context.Background(), tx)
+	if err != nil {
+		t.Fatalf("race returned error: %v", err)
+	}
+	if sig != wantSig {
+		t.Errorf("race returned signature %v, want %v", sig, wantSig)
+	}
+}
+
+func TestSubmitter_anyConfirmed_ReturnsSignatureOnConfirmation(t *testing.T) {
+	sig := solana.Signature{1, 2, 3}
+	server := newFakeRPCServer(t, map[string]func() interface{}{
+		"getSignatureStatuses": func() interface{} {
+			return map[string]interface{}{
+				"context": map[string]interface{}{"slot": 1},
+				"value":   []interface{}{signatureStatusResult(1, "finalized", nil)},
+			}
+		},
+	})
+
+	s := NewSubmitter([]string{server.URL})
+	confirmed, _, err := s.anyConfirmed(context.Background(), map[solana.Signature]bool{sig: true})
+	if err != nil {
+		t.Fatalf("anyConfirmed returned error: %v", err)
+	}
+	if confirmed != sig {
+		t.Errorf("confirmed signature = %v, want %v", confirmed, sig)
+	}
+}
+
+func TestSubmitter_anyConfirmed_ReturnsTxFailedErrorOnChainFailure(t *testing.T) {
+	sig := solana.Signature{1, 2, 3}
+	server := newFakeRPCServer(t, map[string]func() interface{}{
+		"getSignatureStatuses": func() interface{} {
+			return map[string]interface{}{
+				"context": map[string]interface{}{"slot": 1},
+				"value":   []interface{}{signatureStatusResult(1, "finalized", map[string]interface{}{"InstructionError": []interface{}{0, "custom program error"}})},
+			}
+		},
+	})
+
+	s := NewSubmitter([]string{server.URL})
+	_, _, err := s.anyConfirmed(context.Background(), map[solana.Signature]bool{sig: true})
+	if err == nil {
+		t.Fatal("expected an error for an on-chain transaction failure, got nil")
+	}
+
+	var failed *TxFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("expected a *TxFailedError, got %T: %v", err, err)
+	}
+}
+
+func TestSubmitter_anyConfirmed_ReturnsBlockHeightWhilePending(t *testing.T) {
+	sig := solana.Signature{1, 2, 3}
+	server := newFakeRPCServer(t, map[string]func() interface{}{
+		"getSignatureStatuses": func() interface{} {
+			return map[string]interface{}{
+				"context": map[string]interface{}{"slot": 1},
+				"value":   []interface{}{nil},
+			}
+		},
+		"getBlockHeight": func() interface{} { return 42 },
+	})
+
+	s := NewSubmitter([]string{server.URL})
+	confirmed, height, err := s.anyConfirmed(context.Background(), map[solana.Signature]bool{sig: true})
+	if err != nil {
+		t.Fatalf("anyConfirmed returned error: %v", err)
+	}
+	if !confirmed.IsZero() {
+		t.Errorf("expected no confirmed signature while pending, got %v", confirmed)
+	}
+	if height != 42 {
+		t.Errorf("height = %d, want 42", height)
+	}
+}
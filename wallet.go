@@ -0,0 +1,135 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// slip10Seed is the HMAC key used to derive the SLIP-0010 master node, as
+// defined by the SLIP-0010 spec for the ed25519 curve.
+const slip10Seed = "ed25519 seed"
+
+// WalletFromMnemonic derives a Solana wallet from a BIP-39 mnemonic using the
+// SLIP-0010 ed25519 derivation scheme at m/44'/501'/account'/change', which is
+// the path used by Phantom, Solflare, and other standard Solana wallets.
+//
+// ed25519 only supports hardened child derivation, so account and change are
+// always derived as hardened indices regardless of whether they're passed
+// with a trailing apostrophe.
+func WalletFromMnemonic(mnemonic, passphrase, account, change string) (solana.PrivateKey, error) {
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, fmt.Errorf("invalid mnemonic: fails BIP-39 checksum")
+	}
+
+	seed := bip39.NewSeed(mnemonic, passphrase)
+	path := fmt.Sprintf("m/44'/501'/%s'/%s'", strings.TrimSuffix(account, "'"), strings.TrimSuffix(change, "'"))
+
+	key, err := slip10DerivePath(seed, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key for path %q: %w", path, err)
+	}
+
+	return solana.PrivateKey(ed25519.NewKeyFromSeed(key)), nil
+}
+
+// slip10DerivePath walks a derivation path like "m/44'/501'/0'/0'" from the
+// SLIP-0010 master node and returns the resulting 32-byte ed25519 seed.
+func slip10DerivePath(seed []byte, path string) ([]byte, error) {
+	segments := strings.Split(path, "/")
+	if len(segments) == 0 || segments[0] != "m" {
+		return nil, fmt.Errorf("derivation path must start with \"m\", got %q", path)
+	}
+
+	key, chainCode := slip10MasterKey(seed)
+	for _, segment := range segments[1:] {
+		if !strings.HasSuffix(segment, "'") {
+			return nil, fmt.Errorf("segment %q is not hardened: ed25519 derivation only supports hardened indices", segment)
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSuffix(segment, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment %q: %w", segment, err)
+		}
+
+		key, chainCode = slip10ChildKey(key, chainCode, uint32(index)|0x80000000)
+	}
+
+	return key, nil
+}
+
+// slip10MasterKey derives the SLIP-0010 master key and chain code from a
+// BIP-39 seed: I = HMAC-SHA512(key="ed25519 seed", data=seed).
+func slip10MasterKey(seed []byte) (key, chainCode []byte) {
+	mac := hmac.New(sha512.New, []byte(slip10Seed))
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	return i[:32], i[32:]
+}
+
+// slip10ChildKey derives a hardened SLIP-0010 child key:
+// I = HMAC-SHA512(key=parentChainCode, data=0x00 || parentKey || ser32(index)).
+func slip10ChildKey(parentKey, parentChainCode []byte, index uint32) (key, chainCode []byte) {
+	data := make([]byte, 0, 1+len(parentKey)+4)
+	data = append(data, 0x00)
+	data = append(data, parentKey...)
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	mac := hmac.New(sha512.New, parentChainCode)
+	mac.Write(data)
+	i := mac.Sum(nil)
+	return i[:32], i[32:]
+}
+
+// loadWallet resolves the wallet private key from Config, preferring a raw
+// base58 key when present and falling back to SLIP-0010 mnemonic derivation.
+func loadWallet(config Config) (solana.PrivateKey, error) {
+	if config.PrivateKey != "" {
+		privateKey, err := solana.PrivateKeyFromBase58(config.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base58 private key: %w", err)
+		}
+		slog.Info("loaded wallet from base58 private key")
+		return privateKey, nil
+	}
+
+	if config.Mnemonic != "" {
+		account, change, err := parseDerivationPath(config.DerivationPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path: %w", err)
+		}
+
+		privateKey, err := WalletFromMnemonic(config.Mnemonic, "", account, change)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive wallet from mnemonic: %w", err)
+		}
+		slog.Info("loaded wallet from mnemonic", "path", fmt.Sprintf("m/44'/501'/%s'/%s'", account, change))
+		return privateKey, nil
+	}
+
+	return nil, fmt.Errorf("no wallet configured: set private_key or mnemonic in config.json")
+}
+
+// parseDerivationPath splits a Config.DerivationPath value of the form
+// "account/change" (e.g. "0/0") into its two hardened index components. An
+// empty path defaults to account 0, change 0.
+func parseDerivationPath(path string) (account, change string, err error) {
+	if path == "" {
+		return "0", "0", nil
+	}
+
+	parts := strings.Split(path, "/")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected \"account/change\" (e.g. \"0/0\"), got %q", path)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import "testing"
+
+// TestWalletFromMnemonic checks SLIP-0010 derivation against fixed
+// mnemonic/path vectors (the BIP-39 spec test vectors, derived at the
+// standard Solana path and cross-checked against
+// github.com/blocto/solana-go-sdk's hdwallet implementation) so a
+// regression in the hand-rolled derivation fails loudly instead of
+// silently sending a user's funds to an unrecoverable address.
+func TestWalletFromMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+		account  string
+		change   string
+		wantPub  string
+	}{
+		{
+			name:     "trezor test vector, account 0",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			account:  "0",
+			change:   "0",
+			wantPub:  "HAgk14JpMQLgt6rVgv7cBQFJWFto5Dqxi472uT3DKpqk",
+		},
+		{
+			name:     "trezor test vector, account 1",
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			account:  "1",
+			change:   "0",
+			wantPub:  "Hh8QwFUA6MtVu1qAoq12ucvFHNwCcVTV7hpWjeY1Hztb",
+		},
+		{
+			name:     "trezor test vector, different mnemonic",
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank yellow",
+			account:  "0",
+			change:   "0",
+			wantPub:  "BLeUXTx9thHGT7VJUtF9vHEmfMDgW1nnKZ9UVer2CoLX",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := WalletFromMnemonic(tt.mnemonic, "", tt.account, tt.change)
+			if err != nil {
+				t.Fatalf("WalletFromMnemonic returned error: %v", err)
+			}
+			if got := key.PublicKey().String(); got != tt.wantPub {
+				t.Errorf("derived pubkey = %s, want %s", got, tt.wantPub)
+			}
+		})
+	}
+}
+
+// TestWalletFromMnemonic_InvalidMnemonic checks that a mnemonic failing
+// the BIP-39 checksum is rejected rather than silently deriving a key.
+func TestWalletFromMnemonic_InvalidMnemonic(t *testing.T) {
+	_, err := WalletFromMnemonic("not a valid mnemonic at all", "", "0", "0")
+	if err == nil {
+		t.Fatal("expected an error for an invalid mnemonic, got nil")
+	}
+}